@@ -61,29 +61,41 @@ type (
 
 // types from gvc package.
 type (
-	Plugin              = gvc.Plugin
-	Context             = gvc.Context
-	DevicePlugin        = gvc.DevicePlugin
-	DeviceFeature       = gvc.DeviceFeature
-	DevicePluginOption  = gvc.DevicePluginOption
-	RenderPlugin        = gvc.RenderPlugin
-	RenderEngine        = gvc.RenderEngine
-	DefaultRenderEngine = gvc.DefaultRenderEngine
-	RenderFeature       = gvc.RenderFeature
-	RenderPluginOption  = gvc.RenderPluginOption
-	ColorType           = gvc.ColorType
-	LabelType           = gvc.LabelType
-	Job                 = gvc.Job
-	PointFloat          = gvc.PointFloat
-	TextSpan            = gvc.TextSpan
-	TextFont            = gvc.TextFont
-	PostScriptAlias     = gvc.PostScriptAlias
-	Scale               = gvc.Scale
-	Translation         = gvc.Translation
-	ObjectState         = gvc.ObjectState
-	FillType            = gvc.FillType
-	PenType             = gvc.PenType
-	Color               = gvc.Color
+	Plugin                = gvc.Plugin
+	Context               = gvc.Context
+	DevicePlugin          = gvc.DevicePlugin
+	DeviceFeature         = gvc.DeviceFeature
+	DevicePluginOption    = gvc.DevicePluginOption
+	RenderPlugin          = gvc.RenderPlugin
+	RenderEngine          = gvc.RenderEngine
+	DefaultRenderEngine   = gvc.DefaultRenderEngine
+	RenderFeature         = gvc.RenderFeature
+	RenderPluginOption    = gvc.RenderPluginOption
+	ColorType             = gvc.ColorType
+	LabelType             = gvc.LabelType
+	Job                   = gvc.Job
+	PointFloat            = gvc.PointFloat
+	TextSpan              = gvc.TextSpan
+	TextFont              = gvc.TextFont
+	PostScriptAlias       = gvc.PostScriptAlias
+	Scale                 = gvc.Scale
+	Translation           = gvc.Translation
+	ObjectState           = gvc.ObjectState
+	FillType              = gvc.FillType
+	PenType               = gvc.PenType
+	Color                 = gvc.Color
+	ObjectType            = gvc.ObjectType
+	ObjectRenderer        = gvc.ObjectRenderer
+	ObjectRenderEngine    = gvc.ObjectRenderEngine
+	RenderObject          = gvc.RenderObject
+	BoundingBox           = gvc.BoundingBox
+	DrawList              = gvc.DrawList
+	DrawObject            = gvc.DrawObject
+	DrawPrimitive         = gvc.DrawPrimitive
+	DrawText              = gvc.DrawText
+	DrawFont              = gvc.DrawFont
+	TextRenderer          = gvc.TextRenderer
+	TerminalImageRenderer = gvc.TerminalImageRenderer
 )
 
 // variables from cgraph package.
@@ -94,6 +106,19 @@ var (
 	StrictUnDirected = cgraph.StrictUnDirected
 )
 
+// variables from gvc package.
+var (
+	DrawPrimitiveEllipse  = gvc.DrawPrimitiveEllipse
+	DrawPrimitivePolygon  = gvc.DrawPrimitivePolygon
+	DrawPrimitiveBezier   = gvc.DrawPrimitiveBezier
+	DrawPrimitivePolyline = gvc.DrawPrimitivePolyline
+
+	RootGraphObjectType = gvc.RootGraphObjectType
+	ClusterObjectType   = gvc.ClusterObjectType
+	NodeObjectType      = gvc.NodeObjectType
+	EdgeObjectType      = gvc.EdgeObjectType
+)
+
 // const variables from cgraph package.
 const (
 	NormalArrow   = cgraph.NormalArrow
@@ -184,6 +209,7 @@ const (
 	NodePack    = cgraph.NodePack
 	ClusterPack = cgraph.ClusterPack
 	GraphPack   = cgraph.GraphPack
+	ArrayPack   = cgraph.ArrayPack
 )
 
 const (
@@ -332,19 +358,33 @@ var (
 
 // functions from gvc package.
 var (
-	SetFontLoader   = gvc.SetFontLoader
-	DefaultPlugins  = gvc.DefaultPlugins
-	DeviceQuality   = gvc.WithDeviceQuality
-	DeviceFeatures  = gvc.WithDeviceFeatures
-	DeviceDPI       = gvc.WithDeviceDPI
-	NewDevicePlugin = gvc.NewDevicePlugin
-	PNGDevicePlugin = gvc.PNGDevicePlugin
-	JPGDevicePlugin = gvc.JPGDevicePlugin
-	RenderQuality   = gvc.WithRenderQuality
-	RenderFeatures  = gvc.WithRenderFeatures
-	RenderColorType = gvc.WithRenderColorType
-	RenderPAD       = gvc.WithRenderPAD
-	NewRenderPlugin = gvc.NewRenderPlugin
-	PNGRenderPlugin = gvc.PNGRenderPlugin
-	JPGRenderPlugin = gvc.JPGRenderPlugin
+	SetFontLoader         = gvc.SetFontLoader
+	DefaultPlugins        = gvc.DefaultPlugins
+	DeviceQuality         = gvc.WithDeviceQuality
+	DeviceFeatures        = gvc.WithDeviceFeatures
+	DeviceDPI             = gvc.WithDeviceDPI
+	NewDevicePlugin       = gvc.NewDevicePlugin
+	PNGDevicePlugin       = gvc.PNGDevicePlugin
+	JPGDevicePlugin       = gvc.JPGDevicePlugin
+	RenderQuality         = gvc.WithRenderQuality
+	RenderFeatures        = gvc.WithRenderFeatures
+	RenderColorType       = gvc.WithRenderColorType
+	RenderPAD             = gvc.WithRenderPAD
+	NewRenderPlugin       = gvc.NewRenderPlugin
+	PNGRenderPlugin       = gvc.PNGRenderPlugin
+	JPGRenderPlugin       = gvc.JPGRenderPlugin
+	DrawListRenderPlugin  = gvc.DrawListRenderPlugin
+	DrawListDevicePlugin  = gvc.DrawListDevicePlugin
+	NewObjectRenderEngine = gvc.NewObjectRenderEngine
+	TextRenderPlugin      = gvc.TextRenderPlugin
+	TextDevicePlugin      = gvc.TextDevicePlugin
+	ASCIIRenderPlugin     = gvc.ASCIIRenderPlugin
+	ASCIIDevicePlugin     = gvc.ASCIIDevicePlugin
+	SixelRenderPlugin     = gvc.SixelRenderPlugin
+	SixelDevicePlugin     = gvc.SixelDevicePlugin
+	KittyRenderPlugin     = gvc.KittyRenderPlugin
+	KittyDevicePlugin     = gvc.KittyDevicePlugin
+	EncodeSixel           = gvc.EncodeSixel
+	EncodeKitty           = gvc.EncodeKitty
+	PageFileName          = gvc.PageFileName
 )
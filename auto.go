@@ -0,0 +1,182 @@
+package graphviz
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// Auto selects the layout engine for each graph by SelectLayout when it is rendered.
+const Auto Layout = "auto"
+
+const (
+	// largeGraphNodes is the number of nodes from which sfdp is used because the other engines are too slow.
+	largeGraphNodes = 1000
+	// mediumGraphNodes is the number of nodes from which sfdp is used for undirected graphs instead of neato.
+	mediumGraphNodes = 200
+)
+
+// LayoutSelection is the layout engine selected by SelectLayout.
+type LayoutSelection struct {
+	Layout Layout
+	// Attributes are the graph attributes recommended for the layout engine.
+	// They are applied only if the graph does not specify them.
+	Attributes map[string]string
+	// Reasons explain why the layout engine is selected.
+	Reasons []string
+	// Stats are the statistics of the graph used for the selection.
+	Stats *cgraph.GraphStats
+}
+
+func (s *LayoutSelection) String() string {
+	var b strings.Builder
+	b.WriteString(string(s.Layout))
+	for _, name := range sortedAttributeNames(s.Attributes) {
+		fmt.Fprintf(&b, " %s=%s", name, s.Attributes[name])
+	}
+	if len(s.Reasons) > 0 {
+		b.WriteString(": ")
+		b.WriteString(strings.Join(s.Reasons, ", "))
+	}
+	return b.String()
+}
+
+// SelectLayout selects the layout engine for the graph by the size, directedness, acyclicity, clustering and density.
+//
+//   - Graphs with more than 1000 nodes use sfdp with overlap=prism.
+//   - Graphs with clusters use osage if they have no edges, otherwise dot if directed and fdp if undirected.
+//   - Other directed graphs use dot, with rankdir=LR if they are wide and shallow.
+//   - Undirected trees use twopi, and cycles use circo.
+//   - Other undirected graphs use neato, or sfdp with overlap=prism if they have more than 200 nodes.
+func SelectLayout(graph *Graph) (*LayoutSelection, error) {
+	stats, err := cgraph.Stats(graph)
+	if err != nil {
+		return nil, err
+	}
+	s := &LayoutSelection{Attributes: map[string]string{}, Stats: stats}
+	switch {
+	case stats.Nodes == 0:
+		s.Layout = DOT
+		s.reason("the graph is empty")
+	case stats.Nodes > largeGraphNodes:
+		s.Layout = SFDP
+		s.Attributes["overlap"] = "prism"
+		s.reason("the graph has %d nodes which are too many for the other engines", stats.Nodes)
+	case stats.Clusters > 0 && stats.Edges == 0:
+		s.Layout = OSAGE
+		s.reason("the graph has %d clusters without edges", stats.Clusters)
+	case stats.Clusters > 0 && stats.Directed:
+		s.Layout = DOT
+		s.reason("the graph is directed and has %d clusters", stats.Clusters)
+	case stats.Clusters > 0:
+		s.Layout = FDP
+		s.reason("the graph is undirected and has %d clusters", stats.Clusters)
+	case stats.Directed:
+		s.Layout = DOT
+		if stats.IsDAG {
+			s.reason("the graph is a directed acyclic graph")
+		} else {
+			s.reason("the graph is directed")
+		}
+		// a hierarchy much wider than deep fits better from left to right.
+		if stats.IsDAG && stats.Diameter > 0 && stats.Nodes > 4*(stats.Diameter+1)*(stats.Diameter+1) {
+			s.Attributes["rankdir"] = "LR"
+			s.reason("the hierarchy is shallow ( diameter %d ) for %d nodes", stats.Diameter, stats.Nodes)
+		}
+	case stats.IsTree:
+		s.Layout = TWOPI
+		s.reason("the graph is an undirected tree")
+	case isCycle(stats):
+		s.Layout = CIRCO
+		s.reason("the graph is a cycle")
+	case stats.Nodes > mediumGraphNodes:
+		s.Layout = SFDP
+		s.Attributes["overlap"] = "prism"
+		s.reason("the graph is undirected and has %d nodes", stats.Nodes)
+	default:
+		s.Layout = NEATO
+		s.reason("the graph is undirected with density %.2f", stats.Density)
+	}
+	if stats.Components > 1 && (s.Layout == NEATO || s.Layout == SFDP) {
+		s.Attributes["pack"] = "true"
+		s.reason("the %d connected components are packed", stats.Components)
+	}
+	return s, nil
+}
+
+func (s *LayoutSelection) reason(format string, args ...any) {
+	s.Reasons = append(s.Reasons, fmt.Sprintf(format, args...))
+}
+
+func isCycle(stats *cgraph.GraphStats) bool {
+	return stats.Components == 1 && stats.Nodes > 2 && len(stats.Degrees) == 1 && stats.Degrees[2] == stats.Nodes
+}
+
+// LayoutSelection returns the layout engine selected by Auto for the graph rendered last.
+// It returns nil if the layout is not Auto or no graph has been rendered yet.
+func (g *Graphviz) LayoutSelection() *LayoutSelection {
+	return g.selection
+}
+
+// layoutEngine returns the layout engine for the graph.
+// If the layout is Auto, the engine is selected by SelectLayout without modifying the graph.
+func (g *Graphviz) layoutEngine(graph *Graph) (*LayoutSelection, error) {
+	if g.layout != Auto {
+		return &LayoutSelection{Layout: g.layout}, nil
+	}
+	return SelectLayout(graph)
+}
+
+// withLayout lays out the graph, calls render with the laid out graph and frees the layout.
+// If the layout is Auto, the recommended attributes which the graph does not specify are set to a copy of the graph,
+// which is laid out instead, so the graph itself is not modified.
+func (g *Graphviz) withLayout(ctx context.Context, graph *Graph, render func(*Graph) error) (e error) {
+	s, err := g.layoutEngine(graph)
+	if err != nil {
+		return err
+	}
+	if g.layout == Auto {
+		g.selection = s
+	}
+	var names []string
+	for _, name := range sortedAttributeNames(s.Attributes) {
+		if graph.GetStr(name) == "" {
+			names = append(names, name)
+		}
+	}
+	if len(names) > 0 {
+		clone, err := graph.Clone()
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := clone.Close(); err != nil && e == nil {
+				e = err
+			}
+		}()
+		for _, name := range names {
+			if err := clone.SafeSet(name, s.Attributes[name], ""); err != nil {
+				return err
+			}
+		}
+		graph = clone
+	}
+	defer func() {
+		if err := g.ctx.FreeLayout(ctx, graph); err != nil {
+			e = err
+		}
+	}()
+
+	if err := g.ctx.Layout(ctx, graph, string(s.Layout)); err != nil {
+		return err
+	}
+	return render(graph)
+}
+
+func sortedAttributeNames(attrs map[string]string) []string {
+	return slices.Sorted(maps.Keys(attrs))
+}
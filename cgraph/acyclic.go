@@ -0,0 +1,129 @@
+package cgraph
+
+import (
+	"errors"
+)
+
+// Acyclic breaks the cycles of the directed graph by reversing back edges found by a depth-first search
+// in the same way as the acyclic tool of Graphviz. It returns the number of the reversed edges.
+// A reversed edge keeps the key and the attributes, and its tailport and headport are swapped.
+// Self loops are not reversed.
+// https://graphviz.org/docs/cli/acyclic/
+func Acyclic(g *Graph) (int, error) {
+	directed, err := g.IsDirected()
+	if err != nil {
+		return 0, err
+	}
+	if !directed {
+		return 0, errors.New("acyclic requires a directed graph")
+	}
+	defaults, err := attributeDefaults(g, EDGE)
+	if err != nil {
+		return 0, err
+	}
+	a := &acyclic{
+		graph:   g,
+		names:   sortedKeys(defaults),
+		visited: map[string]bool{},
+		onStack: map[string]bool{},
+	}
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return 0, err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return 0, err
+		}
+		if a.visited[name] {
+			continue
+		}
+		if err := a.dfs(n, name); err != nil {
+			return 0, err
+		}
+	}
+	return a.reversed, nil
+}
+
+type acyclic struct {
+	graph *Graph
+	// names are the declared edge attributes.
+	names    []string
+	visited  map[string]bool
+	onStack  map[string]bool
+	reversed int
+}
+
+func (a *acyclic) dfs(n *Node, name string) error {
+	a.visited[name] = true
+	a.onStack[name] = true
+	defer delete(a.onStack, name)
+
+	// out edges are collected first because back edges are deleted while visiting.
+	var edges []*Edge
+	for e, err := range a.graph.OutEdges(n) {
+		if err != nil {
+			return err
+		}
+		edges = append(edges, e)
+	}
+	for _, e := range edges {
+		head, err := e.Head()
+		if err != nil {
+			return err
+		}
+		headName, err := head.Name()
+		if err != nil {
+			return err
+		}
+		switch {
+		case headName == name:
+		case a.onStack[headName]:
+			if err := a.reverse(e, n, head); err != nil {
+				return err
+			}
+		case !a.visited[headName]:
+			if err := a.dfs(head, headName); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *acyclic) reverse(e *Edge, tail, head *Node) error {
+	key, err := e.Name()
+	if err != nil {
+		return err
+	}
+	var rev *Edge
+	if isAnonymousEdgeName(key) {
+		rev, err = createAnonymousEdge(a.graph, head, tail)
+	} else {
+		rev, err = a.graph.CreateEdgeByName(key, head, tail)
+	}
+	if err != nil {
+		return err
+	}
+	for _, name := range a.names {
+		v := e.GetStr(name)
+		switch name {
+		case "tailport":
+			name = "headport"
+		case "headport":
+			name = "tailport"
+		}
+		if v == rev.GetStr(name) {
+			continue
+		}
+		// the swapped port may not be declared.
+		if err := rev.SafeSet(name, v, ""); err != nil {
+			return err
+		}
+	}
+	if _, err := a.graph.DeleteEdge(e); err != nil {
+		return err
+	}
+	a.reversed++
+	return nil
+}
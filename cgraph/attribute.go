@@ -7,6 +7,7 @@ type attribute string
 const (
 	dampingAttr            attribute = "Damping"
 	kAttr                  attribute = "K"
+	tbBalanceAttr          attribute = "TBbalance"
 	urlAttr                attribute = "URL"
 	backgroundAttr         attribute = "_background"
 	areaAttr               attribute = "area"
@@ -14,9 +15,12 @@ const (
 	arrowSizeAttr          attribute = "arrowsize"
 	arrowTailAttr          attribute = "arrowtail"
 	bbAttr                 attribute = "bb"
+	beautifyAttr           attribute = "beautify"
 	bgcolorAttr            attribute = "bgcolor"
 	centerAttr             attribute = "center"
 	charsetAttr            attribute = "charset"
+	classAttr              attribute = "class"
+	clusterAttr            attribute = "cluster"
 	clusterRankAttr        attribute = "clusterrank"
 	colorAttr              attribute = "color"
 	colorSchemeAttr        attribute = "colorscheme"
@@ -90,6 +94,7 @@ const (
 	levelsGapAttr          attribute = "levelsgap"
 	lHeadAttr              attribute = "lhead"
 	lHeightAttr            attribute = "lheight"
+	lineLengthAttr         attribute = "linelength"
 	lpAttr                 attribute = "lp"
 	lTailAttr              attribute = "ltail"
 	lWidthAttr             attribute = "lwidth"
@@ -108,6 +113,7 @@ const (
 	noTranslateAttr        attribute = "notranslate"
 	nsLimitAttr            attribute = "nslimit"
 	nsLimit1Attr           attribute = "nslimit1"
+	oneBlockAttr           attribute = "oneblock"
 	orderingAttr           attribute = "ordering"
 	orientationAttr        attribute = "orientation"
 	outputOrderAttr        attribute = "outputorder"
@@ -571,7 +577,7 @@ func (g *Graph) SetDim(v int) *Graph {
 // All other coordinates will be 2D and, at best, will reflect a projection of a higher-dimensional point onto the plane.
 // https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:dimen
 func (g *Graph) SetDimen(v int) *Graph {
-	g.SafeSet(string(dimAttr), fmt.Sprint(v), "2")
+	g.SafeSet(string(dimenAttr), fmt.Sprint(v), "2")
 	return g
 }
 
@@ -1868,6 +1874,7 @@ const (
 	NodePack    PackMode = "node"
 	ClusterPack PackMode = "clust"
 	GraphPack   PackMode = "graph"
+	ArrayPack   PackMode = "array"
 )
 
 // SetPackMode
@@ -2382,6 +2389,8 @@ const (
 	RArrowShape          Shape = "rarrow"
 	LArrowShape          Shape = "larrow"
 	LPromoterShape       Shape = "lpromoter"
+	RecordShape          Shape = "record"
+	MrecordShape         Shape = "Mrecord"
 )
 
 // SetShape
@@ -0,0 +1,1341 @@
+// Code generated by attrgen. DO NOT EDIT.
+
+package cgraph
+
+// Damping returns the parsed value of Damping attribute.
+// If it is not set or cannot be parsed, the default value "0.99" is returned with false.
+func (g *Graph) Damping() (float64, bool) {
+	return attributeValue(g.GetStr, dampingAttr, "0.99", parseDouble)
+}
+
+// K returns the parsed value of K attribute.
+// If it is not set or cannot be parsed, the default value "0.3" is returned with false.
+func (g *Graph) K() (float64, bool) {
+	return attributeValue(g.GetStr, kAttr, "0.3", parseDouble)
+}
+
+// TBBalance returns the parsed value of TBbalance attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) TBBalance() (string, bool) {
+	return attributeValue(g.GetStr, tbBalanceAttr, "", parseString)
+}
+
+// URL returns the parsed value of URL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) URL() (string, bool) {
+	return attributeValue(g.GetStr, urlAttr, "", parseString)
+}
+
+// URL returns the parsed value of URL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) URL() (string, bool) {
+	return attributeValue(n.GetStr, urlAttr, "", parseString)
+}
+
+// URL returns the parsed value of URL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) URL() (string, bool) {
+	return attributeValue(e.GetStr, urlAttr, "", parseString)
+}
+
+// Background returns the parsed value of _background attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Background() (string, bool) {
+	return attributeValue(g.GetStr, backgroundAttr, "", parseString)
+}
+
+// Area returns the parsed value of area attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (g *Graph) Area() (float64, bool) {
+	return attributeValue(g.GetStr, areaAttr, "1.0", parseDouble)
+}
+
+// Area returns the parsed value of area attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (n *Node) Area() (float64, bool) {
+	return attributeValue(n.GetStr, areaAttr, "1.0", parseDouble)
+}
+
+// ArrowHead returns the parsed value of arrowhead attribute.
+// If it is not set or cannot be parsed, the default value "normal" is returned with false.
+func (e *Edge) ArrowHead() (ArrowType, bool) {
+	return attributeValue(e.GetStr, arrowHeadAttr, "normal", parseEnum[ArrowType])
+}
+
+// ArrowSize returns the parsed value of arrowsize attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (e *Edge) ArrowSize() (float64, bool) {
+	return attributeValue(e.GetStr, arrowSizeAttr, "1.0", parseDouble)
+}
+
+// ArrowTail returns the parsed value of arrowtail attribute.
+// If it is not set or cannot be parsed, the default value "normal" is returned with false.
+func (e *Edge) ArrowTail() (ArrowType, bool) {
+	return attributeValue(e.GetStr, arrowTailAttr, "normal", parseEnum[ArrowType])
+}
+
+// BB returns the parsed value of bb attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) BB() (Rect, bool) {
+	return attributeValue(g.GetStr, bbAttr, "", parseRect)
+}
+
+// Beautify returns the parsed value of beautify attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Beautify() (bool, bool) {
+	return attributeValue(g.GetStr, beautifyAttr, "false", parseBool)
+}
+
+// BackgroundColor returns the parsed value of bgcolor attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) BackgroundColor() (ColorList, bool) {
+	return attributeValue(g.GetStr, bgcolorAttr, "", withColorScheme(g.GetStr, parseColorList))
+}
+
+// Center returns the parsed value of center attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Center() (bool, bool) {
+	return attributeValue(g.GetStr, centerAttr, "false", parseBool)
+}
+
+// Charset returns the parsed value of charset attribute.
+// If it is not set or cannot be parsed, the default value "UTF-8" is returned with false.
+func (g *Graph) Charset() (string, bool) {
+	return attributeValue(g.GetStr, charsetAttr, "UTF-8", parseString)
+}
+
+// Class returns the parsed value of class attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Class() (string, bool) {
+	return attributeValue(g.GetStr, classAttr, "", parseString)
+}
+
+// Class returns the parsed value of class attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Class() (string, bool) {
+	return attributeValue(n.GetStr, classAttr, "", parseString)
+}
+
+// Class returns the parsed value of class attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Class() (string, bool) {
+	return attributeValue(e.GetStr, classAttr, "", parseString)
+}
+
+// Cluster returns the parsed value of cluster attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Cluster() (bool, bool) {
+	return attributeValue(g.GetStr, clusterAttr, "false", parseBool)
+}
+
+// ClusterRank returns the parsed value of clusterrank attribute.
+// If it is not set or cannot be parsed, the default value "local" is returned with false.
+func (g *Graph) ClusterRank() (ClusterMode, bool) {
+	return attributeValue(g.GetStr, clusterRankAttr, "local", parseEnum[ClusterMode])
+}
+
+// Color returns the parsed value of color attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (g *Graph) Color() (ColorList, bool) {
+	return attributeValue(g.GetStr, colorAttr, "black", withColorScheme(g.GetStr, parseColorList))
+}
+
+// Color returns the parsed value of color attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (n *Node) Color() (ColorList, bool) {
+	return attributeValue(n.GetStr, colorAttr, "black", withColorScheme(n.GetStr, parseColorList))
+}
+
+// Color returns the parsed value of color attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (e *Edge) Color() (ColorList, bool) {
+	return attributeValue(e.GetStr, colorAttr, "black", withColorScheme(e.GetStr, parseColorList))
+}
+
+// ColorScheme returns the parsed value of colorscheme attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) ColorScheme() (string, bool) {
+	return attributeValue(g.GetStr, colorSchemeAttr, "", parseString)
+}
+
+// ColorScheme returns the parsed value of colorscheme attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) ColorScheme() (string, bool) {
+	return attributeValue(n.GetStr, colorSchemeAttr, "", parseString)
+}
+
+// ColorScheme returns the parsed value of colorscheme attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) ColorScheme() (string, bool) {
+	return attributeValue(e.GetStr, colorSchemeAttr, "", parseString)
+}
+
+// Comment returns the parsed value of comment attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Comment() (string, bool) {
+	return attributeValue(g.GetStr, commentAttr, "", parseString)
+}
+
+// Comment returns the parsed value of comment attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Comment() (string, bool) {
+	return attributeValue(n.GetStr, commentAttr, "", parseString)
+}
+
+// Comment returns the parsed value of comment attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Comment() (string, bool) {
+	return attributeValue(e.GetStr, commentAttr, "", parseString)
+}
+
+// Compound returns the parsed value of compound attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Compound() (bool, bool) {
+	return attributeValue(g.GetStr, compoundAttr, "false", parseBool)
+}
+
+// Concentrate returns the parsed value of concentrate attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Concentrate() (bool, bool) {
+	return attributeValue(g.GetStr, concentrateAttr, "false", parseBool)
+}
+
+// Constraint returns the parsed value of constraint attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (e *Edge) Constraint() (bool, bool) {
+	return attributeValue(e.GetStr, constraintAttr, "true", parseBool)
+}
+
+// Decorate returns the parsed value of decorate attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (e *Edge) Decorate() (bool, bool) {
+	return attributeValue(e.GetStr, decorateAttr, "false", parseBool)
+}
+
+// DefaultDist returns the parsed value of defaultdist attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) DefaultDist() (float64, bool) {
+	return attributeValue(g.GetStr, defaultDistAttr, "", parseDouble)
+}
+
+// Dim returns the parsed value of dim attribute.
+// If it is not set or cannot be parsed, the default value "2" is returned with false.
+func (g *Graph) Dim() (int, bool) {
+	return attributeValue(g.GetStr, dimAttr, "2", parseInt)
+}
+
+// Dimen returns the parsed value of dimen attribute.
+// If it is not set or cannot be parsed, the default value "2" is returned with false.
+func (g *Graph) Dimen() (int, bool) {
+	return attributeValue(g.GetStr, dimenAttr, "2", parseInt)
+}
+
+// Dir returns the parsed value of dir attribute.
+// If it is not set or cannot be parsed, the default value "forward" is returned with false.
+func (e *Edge) Dir() (DirType, bool) {
+	return attributeValue(e.GetStr, dirAttr, "forward", parseEnum[DirType])
+}
+
+// DirEdgeConstraints returns the parsed value of diredgeconstraints attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) DirEdgeConstraints() (string, bool) {
+	return attributeValue(g.GetStr, dirEdgeConstraintsAttr, "false", parseString)
+}
+
+// Distortion returns the parsed value of distortion attribute.
+// If it is not set or cannot be parsed, the default value "0.0" is returned with false.
+func (n *Node) Distortion() (float64, bool) {
+	return attributeValue(n.GetStr, distortionAttr, "0.0", parseDouble)
+}
+
+// DPI returns the parsed value of dpi attribute.
+// If it is not set or cannot be parsed, the default value "96.0" is returned with false.
+func (g *Graph) DPI() (float64, bool) {
+	return attributeValue(g.GetStr, dpiAttr, "96.0", parseDouble)
+}
+
+// EdgeURL returns the parsed value of edgeURL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) EdgeURL() (string, bool) {
+	return attributeValue(e.GetStr, edgeURLAttr, "", parseString)
+}
+
+// EdgeHref returns the parsed value of edgehref attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) EdgeHref() (string, bool) {
+	return attributeValue(e.GetStr, edgeHrefAttr, "", parseString)
+}
+
+// EdgeTarget returns the parsed value of edgetarget attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) EdgeTarget() (string, bool) {
+	return attributeValue(e.GetStr, edgeTargetAttr, "", parseString)
+}
+
+// EdgeTooltip returns the parsed value of edgetooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) EdgeTooltip() (string, bool) {
+	return attributeValue(e.GetStr, edgeTooltipAttr, "", parseString)
+}
+
+// Epsilon returns the parsed value of epsilon attribute.
+// If it is not set or cannot be parsed, the default value ".0001" is returned with false.
+func (g *Graph) Epsilon() (float64, bool) {
+	return attributeValue(g.GetStr, epsilonAttr, ".0001", parseDouble)
+}
+
+// ESep returns the parsed value of esep attribute.
+// If it is not set or cannot be parsed, the default value "+3" is returned with false.
+func (g *Graph) ESep() (Point, bool) {
+	return attributeValue(g.GetStr, esepAttr, "+3", parsePoint)
+}
+
+// FillColor returns the parsed value of fillcolor attribute.
+// If it is not set or cannot be parsed, the default value "lightgrey" is returned with false.
+func (g *Graph) FillColor() (ColorList, bool) {
+	return attributeValue(g.GetStr, fillColorAttr, "lightgrey", withColorScheme(g.GetStr, parseColorList))
+}
+
+// FillColor returns the parsed value of fillcolor attribute.
+// If it is not set or cannot be parsed, the default value "lightgrey" is returned with false.
+func (n *Node) FillColor() (ColorList, bool) {
+	return attributeValue(n.GetStr, fillColorAttr, "lightgrey", withColorScheme(n.GetStr, parseColorList))
+}
+
+// FillColor returns the parsed value of fillcolor attribute.
+// If it is not set or cannot be parsed, the default value "lightgrey" is returned with false.
+func (e *Edge) FillColor() (ColorList, bool) {
+	return attributeValue(e.GetStr, fillColorAttr, "lightgrey", withColorScheme(e.GetStr, parseColorList))
+}
+
+// FontColor returns the parsed value of fontcolor attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (g *Graph) FontColor() (Color, bool) {
+	return attributeValue(g.GetStr, fontColorAttr, "black", withColorScheme(g.GetStr, parseColor))
+}
+
+// FontColor returns the parsed value of fontcolor attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (n *Node) FontColor() (Color, bool) {
+	return attributeValue(n.GetStr, fontColorAttr, "black", withColorScheme(n.GetStr, parseColor))
+}
+
+// FontColor returns the parsed value of fontcolor attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (e *Edge) FontColor() (Color, bool) {
+	return attributeValue(e.GetStr, fontColorAttr, "black", withColorScheme(e.GetStr, parseColor))
+}
+
+// FontName returns the parsed value of fontname attribute.
+// If it is not set or cannot be parsed, the default value "Times-Roman" is returned with false.
+func (g *Graph) FontName() (string, bool) {
+	return attributeValue(g.GetStr, fontNameAttr, "Times-Roman", parseString)
+}
+
+// FontName returns the parsed value of fontname attribute.
+// If it is not set or cannot be parsed, the default value "Times-Roman" is returned with false.
+func (n *Node) FontName() (string, bool) {
+	return attributeValue(n.GetStr, fontNameAttr, "Times-Roman", parseString)
+}
+
+// FontName returns the parsed value of fontname attribute.
+// If it is not set or cannot be parsed, the default value "Times-Roman" is returned with false.
+func (e *Edge) FontName() (string, bool) {
+	return attributeValue(e.GetStr, fontNameAttr, "Times-Roman", parseString)
+}
+
+// FontNames returns the parsed value of fontnames attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) FontNames() (string, bool) {
+	return attributeValue(g.GetStr, fontNamesAttr, "", parseString)
+}
+
+// FontPath returns the parsed value of fontpath attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) FontPath() (string, bool) {
+	return attributeValue(g.GetStr, fontPathAttr, "", parseString)
+}
+
+// FontSize returns the parsed value of fontsize attribute.
+// If it is not set or cannot be parsed, the default value "14.0" is returned with false.
+func (g *Graph) FontSize() (float64, bool) {
+	return attributeValue(g.GetStr, fontSizeAttr, "14.0", parseDouble)
+}
+
+// FontSize returns the parsed value of fontsize attribute.
+// If it is not set or cannot be parsed, the default value "14.0" is returned with false.
+func (n *Node) FontSize() (float64, bool) {
+	return attributeValue(n.GetStr, fontSizeAttr, "14.0", parseDouble)
+}
+
+// FontSize returns the parsed value of fontsize attribute.
+// If it is not set or cannot be parsed, the default value "14.0" is returned with false.
+func (e *Edge) FontSize() (float64, bool) {
+	return attributeValue(e.GetStr, fontSizeAttr, "14.0", parseDouble)
+}
+
+// ForceLabels returns the parsed value of forcelabels attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (g *Graph) ForceLabels() (bool, bool) {
+	return attributeValue(g.GetStr, forceLabelsAttr, "true", parseBool)
+}
+
+// GradientAngle returns the parsed value of gradientangle attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) GradientAngle() (int, bool) {
+	return attributeValue(g.GetStr, gradientAngleAttr, "", parseInt)
+}
+
+// GradientAngle returns the parsed value of gradientangle attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) GradientAngle() (int, bool) {
+	return attributeValue(n.GetStr, gradientAngleAttr, "", parseInt)
+}
+
+// Group returns the parsed value of group attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Group() (string, bool) {
+	return attributeValue(n.GetStr, groupAttr, "", parseString)
+}
+
+// HeadURL returns the parsed value of headURL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) HeadURL() (string, bool) {
+	return attributeValue(e.GetStr, headURLAttr, "", parseString)
+}
+
+// HeadLabelPoint returns the parsed value of head_lp attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) HeadLabelPoint() (Point, bool) {
+	return attributeValue(e.GetStr, headLpAttr, "", parsePoint)
+}
+
+// HeadClip returns the parsed value of headclip attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (e *Edge) HeadClip() (bool, bool) {
+	return attributeValue(e.GetStr, headClipAttr, "true", parseBool)
+}
+
+// HeadHref returns the parsed value of headhref attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) HeadHref() (string, bool) {
+	return attributeValue(e.GetStr, headHrefAttr, "", parseString)
+}
+
+// HeadLabel returns the parsed value of headlabel attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) HeadLabel() (string, bool) {
+	return attributeValue(e.GetStr, headLabelAttr, "", parseString)
+}
+
+// HeadPort returns the parsed value of headport attribute.
+// If it is not set or cannot be parsed, the default value "center" is returned with false.
+func (e *Edge) HeadPort() (string, bool) {
+	return attributeValue(e.GetStr, headPortAttr, "center", parseString)
+}
+
+// HeadTarget returns the parsed value of headtarget attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) HeadTarget() (string, bool) {
+	return attributeValue(e.GetStr, headTargetAttr, "", parseString)
+}
+
+// HeadTooltip returns the parsed value of headtooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) HeadTooltip() (string, bool) {
+	return attributeValue(e.GetStr, headTooltipAttr, "", parseString)
+}
+
+// Height returns the parsed value of height attribute.
+// If it is not set or cannot be parsed, the default value "0.5" is returned with false.
+func (n *Node) Height() (float64, bool) {
+	return attributeValue(n.GetStr, heightAttr, "0.5", parseDouble)
+}
+
+// Href returns the parsed value of href attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Href() (string, bool) {
+	return attributeValue(g.GetStr, hrefAttr, "", parseString)
+}
+
+// Href returns the parsed value of href attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Href() (string, bool) {
+	return attributeValue(n.GetStr, hrefAttr, "", parseString)
+}
+
+// Href returns the parsed value of href attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Href() (string, bool) {
+	return attributeValue(e.GetStr, hrefAttr, "", parseString)
+}
+
+// ID returns the parsed value of id attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) ID() (string, bool) {
+	return attributeValue(g.GetStr, idAttr, "", parseString)
+}
+
+// ID returns the parsed value of id attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) ID() (string, bool) {
+	return attributeValue(n.GetStr, idAttr, "", parseString)
+}
+
+// ID returns the parsed value of id attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) ID() (string, bool) {
+	return attributeValue(e.GetStr, idAttr, "", parseString)
+}
+
+// Image returns the parsed value of image attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Image() (string, bool) {
+	return attributeValue(n.GetStr, imageAttr, "", parseString)
+}
+
+// ImagePath returns the parsed value of imagepath attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) ImagePath() (string, bool) {
+	return attributeValue(g.GetStr, imagePathAttr, "", parseString)
+}
+
+// ImagePos returns the parsed value of imagepos attribute.
+// If it is not set or cannot be parsed, the default value "mc" is returned with false.
+func (n *Node) ImagePos() (ImagePos, bool) {
+	return attributeValue(n.GetStr, imagePosAttr, "mc", parseEnum[ImagePos])
+}
+
+// InputScale returns the parsed value of inputscale attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) InputScale() (float64, bool) {
+	return attributeValue(g.GetStr, inputScaleAttr, "", parseDouble)
+}
+
+// LabelURL returns the parsed value of labelURL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LabelURL() (string, bool) {
+	return attributeValue(e.GetStr, labelURLAttr, "", parseString)
+}
+
+// LabelScheme returns the parsed value of label_scheme attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (g *Graph) LabelScheme() (int, bool) {
+	return attributeValue(g.GetStr, labelSchemeAttr, "0", parseInt)
+}
+
+// LabelAngle returns the parsed value of labelangle attribute.
+// If it is not set or cannot be parsed, the default value "-25.0" is returned with false.
+func (e *Edge) LabelAngle() (float64, bool) {
+	return attributeValue(e.GetStr, labelAngleAttr, "-25.0", parseDouble)
+}
+
+// LabelDistance returns the parsed value of labeldistance attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (e *Edge) LabelDistance() (float64, bool) {
+	return attributeValue(e.GetStr, labelDistanceAttr, "1.0", parseDouble)
+}
+
+// LabelFloat returns the parsed value of labelfloat attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (e *Edge) LabelFloat() (bool, bool) {
+	return attributeValue(e.GetStr, labelFloatAttr, "false", parseBool)
+}
+
+// LabelFontColor returns the parsed value of labelfontcolor attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (e *Edge) LabelFontColor() (Color, bool) {
+	return attributeValue(e.GetStr, labelFontColorAttr, "black", withColorScheme(e.GetStr, parseColor))
+}
+
+// LabelFontName returns the parsed value of labelfontname attribute.
+// If it is not set or cannot be parsed, the default value "Times-Roman" is returned with false.
+func (e *Edge) LabelFontName() (string, bool) {
+	return attributeValue(e.GetStr, labelFontNameAttr, "Times-Roman", parseString)
+}
+
+// LabelFontSize returns the parsed value of labelfontsize attribute.
+// If it is not set or cannot be parsed, the default value "14.0" is returned with false.
+func (e *Edge) LabelFontSize() (float64, bool) {
+	return attributeValue(e.GetStr, labelFontSizeAttr, "14.0", parseDouble)
+}
+
+// LabelHref returns the parsed value of labelhref attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LabelHref() (string, bool) {
+	return attributeValue(e.GetStr, labelHrefAttr, "", parseString)
+}
+
+// LabelJust returns the parsed value of labeljust attribute.
+// If it is not set or cannot be parsed, the default value "c" is returned with false.
+func (g *Graph) LabelJust() (JustType, bool) {
+	return attributeValue(g.GetStr, labelJustAttr, "c", parseEnum[JustType])
+}
+
+// LabelLocation returns the parsed value of labelloc attribute.
+// If it is not set or cannot be parsed, the default value "b" is returned with false.
+func (g *Graph) LabelLocation() (LabelLocation, bool) {
+	return attributeValue(g.GetStr, labelLocAttr, "b", parseEnum[LabelLocation])
+}
+
+// LabelLocation returns the parsed value of labelloc attribute.
+// If it is not set or cannot be parsed, the default value "c" is returned with false.
+func (n *Node) LabelLocation() (LabelLocation, bool) {
+	return attributeValue(n.GetStr, labelLocAttr, "c", parseEnum[LabelLocation])
+}
+
+// LabelTarget returns the parsed value of labeltarget attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LabelTarget() (string, bool) {
+	return attributeValue(e.GetStr, labelTargetAttr, "", parseString)
+}
+
+// LabelTooltip returns the parsed value of labeltooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LabelTooltip() (string, bool) {
+	return attributeValue(e.GetStr, labelTooltipAttr, "", parseString)
+}
+
+// Landscape returns the parsed value of landscape attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Landscape() (bool, bool) {
+	return attributeValue(g.GetStr, landscapeAttr, "false", parseBool)
+}
+
+// Layer returns the parsed value of layer attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Layer() (string, bool) {
+	return attributeValue(g.GetStr, layerAttr, "", parseString)
+}
+
+// Layer returns the parsed value of layer attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Layer() (string, bool) {
+	return attributeValue(n.GetStr, layerAttr, "", parseString)
+}
+
+// Layer returns the parsed value of layer attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Layer() (string, bool) {
+	return attributeValue(e.GetStr, layerAttr, "", parseString)
+}
+
+// LayerListSeparator returns the parsed value of layerlistsep attribute.
+// If it is not set or cannot be parsed, the default value "," is returned with false.
+func (g *Graph) LayerListSeparator() (string, bool) {
+	return attributeValue(g.GetStr, layerListSepAttr, ",", parseString)
+}
+
+// Layers returns the parsed value of layers attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Layers() (string, bool) {
+	return attributeValue(g.GetStr, layersAttr, "", parseString)
+}
+
+// LayerSelect returns the parsed value of layerselect attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) LayerSelect() (string, bool) {
+	return attributeValue(g.GetStr, layerSelectAttr, "", parseString)
+}
+
+// LayerSeparator returns the parsed value of layersep attribute.
+// If it is not set or cannot be parsed, the default value ":\t " is returned with false.
+func (g *Graph) LayerSeparator() (string, bool) {
+	return attributeValue(g.GetStr, layerSepAttr, ":\t ", parseString)
+}
+
+// Layout returns the parsed value of layout attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Layout() (string, bool) {
+	return attributeValue(g.GetStr, layoutAttr, "", parseString)
+}
+
+// Len returns the parsed value of len attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (e *Edge) Len() (float64, bool) {
+	return attributeValue(e.GetStr, lenAttr, "1.0", parseDouble)
+}
+
+// Levels returns the parsed value of levels attribute.
+// If it is not set or cannot be parsed, the default value "2147483647" is returned with false.
+func (g *Graph) Levels() (int, bool) {
+	return attributeValue(g.GetStr, levelsAttr, "2147483647", parseInt)
+}
+
+// LevelsGap returns the parsed value of levelsgap attribute.
+// If it is not set or cannot be parsed, the default value "0.0" is returned with false.
+func (g *Graph) LevelsGap() (float64, bool) {
+	return attributeValue(g.GetStr, levelsGapAttr, "0.0", parseDouble)
+}
+
+// LogicalHead returns the parsed value of lhead attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LogicalHead() (string, bool) {
+	return attributeValue(e.GetStr, lHeadAttr, "", parseString)
+}
+
+// LabelHeight returns the parsed value of lheight attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) LabelHeight() (float64, bool) {
+	return attributeValue(g.GetStr, lHeightAttr, "", parseDouble)
+}
+
+// LineLength returns the parsed value of linelength attribute.
+// If it is not set or cannot be parsed, the default value "128" is returned with false.
+func (g *Graph) LineLength() (int, bool) {
+	return attributeValue(g.GetStr, lineLengthAttr, "128", parseInt)
+}
+
+// LabelPosition returns the parsed value of lp attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) LabelPosition() (Point, bool) {
+	return attributeValue(g.GetStr, lpAttr, "", parsePoint)
+}
+
+// LabelPosition returns the parsed value of lp attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LabelPosition() (Point, bool) {
+	return attributeValue(e.GetStr, lpAttr, "", parsePoint)
+}
+
+// LogicalTail returns the parsed value of ltail attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) LogicalTail() (string, bool) {
+	return attributeValue(e.GetStr, lTailAttr, "", parseString)
+}
+
+// LabelWidth returns the parsed value of lwidth attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) LabelWidth() (float64, bool) {
+	return attributeValue(g.GetStr, lWidthAttr, "", parseDouble)
+}
+
+// Margin returns the parsed value of margin attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Margin() (Point, bool) {
+	return attributeValue(g.GetStr, marginAttr, "", parsePoint)
+}
+
+// Margin returns the parsed value of margin attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Margin() (Point, bool) {
+	return attributeValue(n.GetStr, marginAttr, "", parsePoint)
+}
+
+// MaxIterator returns the parsed value of maxiter attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) MaxIterator() (int, bool) {
+	return attributeValue(g.GetStr, maxIterAttr, "", parseInt)
+}
+
+// MCLimit returns the parsed value of mclimit attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (g *Graph) MCLimit() (float64, bool) {
+	return attributeValue(g.GetStr, mcLimitAttr, "1.0", parseDouble)
+}
+
+// MinDist returns the parsed value of mindist attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (g *Graph) MinDist() (float64, bool) {
+	return attributeValue(g.GetStr, minDistAttr, "1.0", parseDouble)
+}
+
+// MinLen returns the parsed value of minlen attribute.
+// If it is not set or cannot be parsed, the default value "1" is returned with false.
+func (e *Edge) MinLen() (int, bool) {
+	return attributeValue(e.GetStr, minLenAttr, "1", parseInt)
+}
+
+// Mode returns the parsed value of mode attribute.
+// If it is not set or cannot be parsed, the default value "major" is returned with false.
+func (g *Graph) Mode() (ModeType, bool) {
+	return attributeValue(g.GetStr, modeAttr, "major", parseEnum[ModeType])
+}
+
+// Model returns the parsed value of model attribute.
+// If it is not set or cannot be parsed, the default value "shortpath" is returned with false.
+func (g *Graph) Model() (ModelType, bool) {
+	return attributeValue(g.GetStr, modelAttr, "shortpath", parseEnum[ModelType])
+}
+
+// Mosek returns the parsed value of mosek attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Mosek() (bool, bool) {
+	return attributeValue(g.GetStr, mosekAttr, "false", parseBool)
+}
+
+// NewRank returns the parsed value of newrank attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) NewRank() (bool, bool) {
+	return attributeValue(g.GetStr, newRankAttr, "false", parseBool)
+}
+
+// NodeSeparator returns the parsed value of nodesep attribute.
+// If it is not set or cannot be parsed, the default value "0.25" is returned with false.
+func (g *Graph) NodeSeparator() (float64, bool) {
+	return attributeValue(g.GetStr, nodeSepAttr, "0.25", parseDouble)
+}
+
+// NoJustify returns the parsed value of nojustify attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) NoJustify() (bool, bool) {
+	return attributeValue(g.GetStr, noJustifyAttr, "false", parseBool)
+}
+
+// NoJustify returns the parsed value of nojustify attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (n *Node) NoJustify() (bool, bool) {
+	return attributeValue(n.GetStr, noJustifyAttr, "false", parseBool)
+}
+
+// NoJustify returns the parsed value of nojustify attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (e *Edge) NoJustify() (bool, bool) {
+	return attributeValue(e.GetStr, noJustifyAttr, "false", parseBool)
+}
+
+// Normalize returns the parsed value of normalize attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Normalize() (bool, bool) {
+	return attributeValue(g.GetStr, normalizeAttr, "false", parseBool)
+}
+
+// NoTranslate returns the parsed value of notranslate attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) NoTranslate() (bool, bool) {
+	return attributeValue(g.GetStr, noTranslateAttr, "false", parseBool)
+}
+
+// NsLimit returns the parsed value of nslimit attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) NsLimit() (float64, bool) {
+	return attributeValue(g.GetStr, nsLimitAttr, "", parseDouble)
+}
+
+// NsLimit1 returns the parsed value of nslimit1 attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) NsLimit1() (float64, bool) {
+	return attributeValue(g.GetStr, nsLimit1Attr, "", parseDouble)
+}
+
+// OneBlock returns the parsed value of oneblock attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) OneBlock() (bool, bool) {
+	return attributeValue(g.GetStr, oneBlockAttr, "false", parseBool)
+}
+
+// Ordering returns the parsed value of ordering attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Ordering() (OrderingType, bool) {
+	return attributeValue(g.GetStr, orderingAttr, "", parseEnum[OrderingType])
+}
+
+// Ordering returns the parsed value of ordering attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Ordering() (OrderingType, bool) {
+	return attributeValue(n.GetStr, orderingAttr, "", parseEnum[OrderingType])
+}
+
+// Orientation returns the parsed value of orientation attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Orientation() (string, bool) {
+	return attributeValue(g.GetStr, orientationAttr, "", parseString)
+}
+
+// Orientation returns the parsed value of orientation attribute.
+// If it is not set or cannot be parsed, the default value "0.0" is returned with false.
+func (n *Node) Orientation() (float64, bool) {
+	return attributeValue(n.GetStr, orientationAttr, "0.0", parseDouble)
+}
+
+// OutputOrder returns the parsed value of outputorder attribute.
+// If it is not set or cannot be parsed, the default value "breadthfirst" is returned with false.
+func (g *Graph) OutputOrder() (OutputMode, bool) {
+	return attributeValue(g.GetStr, outputOrderAttr, "breadthfirst", parseEnum[OutputMode])
+}
+
+// Overlap returns the parsed value of overlap attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (g *Graph) Overlap() (string, bool) {
+	return attributeValue(g.GetStr, overlapAttr, "true", parseString)
+}
+
+// OverlapScaling returns the parsed value of overlap_scaling attribute.
+// If it is not set or cannot be parsed, the default value "-4" is returned with false.
+func (g *Graph) OverlapScaling() (float64, bool) {
+	return attributeValue(g.GetStr, overlapScalingAttr, "-4", parseDouble)
+}
+
+// OverlapShrink returns the parsed value of overlap_shrink attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (g *Graph) OverlapShrink() (bool, bool) {
+	return attributeValue(g.GetStr, overlapShrinkAttr, "true", parseBool)
+}
+
+// Pack returns the parsed value of pack attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (g *Graph) Pack() (bool, bool) {
+	return attributeValue(g.GetStr, packAttr, "false", parseBool)
+}
+
+// PackMode returns the parsed value of packmode attribute.
+// If it is not set or cannot be parsed, the default value "node" is returned with false.
+func (g *Graph) PackMode() (PackMode, bool) {
+	return attributeValue(g.GetStr, packModeAttr, "node", parseEnum[PackMode])
+}
+
+// Pad returns the parsed value of pad attribute.
+// If it is not set or cannot be parsed, the default value "0.0555" is returned with false.
+func (g *Graph) Pad() (Point, bool) {
+	return attributeValue(g.GetStr, padAttr, "0.0555", parsePoint)
+}
+
+// Page returns the parsed value of page attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Page() (Point, bool) {
+	return attributeValue(g.GetStr, pageAttr, "", parsePoint)
+}
+
+// PageDir returns the parsed value of pagedir attribute.
+// If it is not set or cannot be parsed, the default value "BL" is returned with false.
+func (g *Graph) PageDir() (PageDir, bool) {
+	return attributeValue(g.GetStr, pageDirAttr, "BL", parseEnum[PageDir])
+}
+
+// PenColor returns the parsed value of pencolor attribute.
+// If it is not set or cannot be parsed, the default value "black" is returned with false.
+func (g *Graph) PenColor() (Color, bool) {
+	return attributeValue(g.GetStr, penColorAttr, "black", withColorScheme(g.GetStr, parseColor))
+}
+
+// PenWidth returns the parsed value of penwidth attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (g *Graph) PenWidth() (float64, bool) {
+	return attributeValue(g.GetStr, penWidthAttr, "1.0", parseDouble)
+}
+
+// PenWidth returns the parsed value of penwidth attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (n *Node) PenWidth() (float64, bool) {
+	return attributeValue(n.GetStr, penWidthAttr, "1.0", parseDouble)
+}
+
+// PenWidth returns the parsed value of penwidth attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (e *Edge) PenWidth() (float64, bool) {
+	return attributeValue(e.GetStr, penWidthAttr, "1.0", parseDouble)
+}
+
+// Peripheries returns the parsed value of peripheries attribute.
+// If it is not set or cannot be parsed, the default value "1" is returned with false.
+func (g *Graph) Peripheries() (int, bool) {
+	return attributeValue(g.GetStr, peripheriesAttr, "1", parseInt)
+}
+
+// Peripheries returns the parsed value of peripheries attribute.
+// If it is not set or cannot be parsed, the default value "1" is returned with false.
+func (n *Node) Peripheries() (int, bool) {
+	return attributeValue(n.GetStr, peripheriesAttr, "1", parseInt)
+}
+
+// Pin returns the parsed value of pin attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (n *Node) Pin() (bool, bool) {
+	return attributeValue(n.GetStr, pinAttr, "false", parseBool)
+}
+
+// Pos returns the parsed value of pos attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Pos() (Point, bool) {
+	return attributeValue(n.GetStr, posAttr, "", parsePoint)
+}
+
+// Pos returns the parsed value of pos attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Pos() ([]Spline, bool) {
+	return attributeValue(e.GetStr, posAttr, "", parseSplines)
+}
+
+// QuadTree returns the parsed value of quadtree attribute.
+// If it is not set or cannot be parsed, the default value "normal" is returned with false.
+func (g *Graph) QuadTree() (QuadType, bool) {
+	return attributeValue(g.GetStr, quadTreeAttr, "normal", parseEnum[QuadType])
+}
+
+// Quantum returns the parsed value of quantum attribute.
+// If it is not set or cannot be parsed, the default value "0.0" is returned with false.
+func (g *Graph) Quantum() (float64, bool) {
+	return attributeValue(g.GetStr, quantumAttr, "0.0", parseDouble)
+}
+
+// Rank returns the parsed value of rank attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Rank() (string, bool) {
+	return attributeValue(g.GetStr, rankAttr, "", parseString)
+}
+
+// RankDir returns the parsed value of rankdir attribute.
+// If it is not set or cannot be parsed, the default value "TB" is returned with false.
+func (g *Graph) RankDir() (RankDir, bool) {
+	return attributeValue(g.GetStr, rankDirAttr, "TB", parseEnum[RankDir])
+}
+
+// RankSeparator returns the parsed value of ranksep attribute.
+// If it is not set or cannot be parsed, the default value "0.5" is returned with false.
+func (g *Graph) RankSeparator() (float64, bool) {
+	return attributeValue(g.GetStr, rankSepAttr, "0.5", parseDouble)
+}
+
+// Ratio returns the parsed value of ratio attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Ratio() (RatioType, bool) {
+	return attributeValue(g.GetStr, ratioAttr, "", parseEnum[RatioType])
+}
+
+// Rects returns the parsed value of rects attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Rects() (Rect, bool) {
+	return attributeValue(n.GetStr, rectsAttr, "", parseRect)
+}
+
+// Regular returns the parsed value of regular attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (n *Node) Regular() (bool, bool) {
+	return attributeValue(n.GetStr, regularAttr, "false", parseBool)
+}
+
+// ReminCross returns the parsed value of remincross attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (g *Graph) ReminCross() (bool, bool) {
+	return attributeValue(g.GetStr, remincrossAttr, "true", parseBool)
+}
+
+// RepulsiveForce returns the parsed value of repulsiveforce attribute.
+// If it is not set or cannot be parsed, the default value "1.0" is returned with false.
+func (g *Graph) RepulsiveForce() (float64, bool) {
+	return attributeValue(g.GetStr, repulsiveforceAttr, "1.0", parseDouble)
+}
+
+// Resolution returns the parsed value of resolution attribute.
+// If it is not set or cannot be parsed, the default value "96.0" is returned with false.
+func (g *Graph) Resolution() (float64, bool) {
+	return attributeValue(g.GetStr, resolutionAttr, "96.0", parseDouble)
+}
+
+// RootNode returns the parsed value of root attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) RootNode() (string, bool) {
+	return attributeValue(g.GetStr, rootAttr, "", parseString)
+}
+
+// IsRoot returns the parsed value of root attribute.
+// If it is not set or cannot be parsed, the default value "false" is returned with false.
+func (n *Node) IsRoot() (bool, bool) {
+	return attributeValue(n.GetStr, rootAttr, "false", parseBool)
+}
+
+// Rotate returns the parsed value of rotate attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (g *Graph) Rotate() (int, bool) {
+	return attributeValue(g.GetStr, rotateAttr, "0", parseInt)
+}
+
+// Rotation returns the parsed value of rotation attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (g *Graph) Rotation() (float64, bool) {
+	return attributeValue(g.GetStr, rotationAttr, "0", parseDouble)
+}
+
+// SameHead returns the parsed value of samehead attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) SameHead() (string, bool) {
+	return attributeValue(e.GetStr, sameHeadAttr, "", parseString)
+}
+
+// SameTail returns the parsed value of sametail attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) SameTail() (string, bool) {
+	return attributeValue(e.GetStr, sameTailAttr, "", parseString)
+}
+
+// SamplePoints returns the parsed value of samplepoints attribute.
+// If it is not set or cannot be parsed, the default value "8" is returned with false.
+func (n *Node) SamplePoints() (int, bool) {
+	return attributeValue(n.GetStr, samplePointsAttr, "8", parseInt)
+}
+
+// Scale returns the parsed value of scale attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Scale() (Point, bool) {
+	return attributeValue(g.GetStr, scaleAttr, "", parsePoint)
+}
+
+// SearchSize returns the parsed value of searchsize attribute.
+// If it is not set or cannot be parsed, the default value "30" is returned with false.
+func (g *Graph) SearchSize() (int, bool) {
+	return attributeValue(g.GetStr, searchSizeAttr, "30", parseInt)
+}
+
+// Separator returns the parsed value of sep attribute.
+// If it is not set or cannot be parsed, the default value "+4" is returned with false.
+func (g *Graph) Separator() (Point, bool) {
+	return attributeValue(g.GetStr, sepAttr, "+4", parsePoint)
+}
+
+// Shape returns the parsed value of shape attribute.
+// If it is not set or cannot be parsed, the default value "ellipse" is returned with false.
+func (n *Node) Shape() (Shape, bool) {
+	return attributeValue(n.GetStr, shapeAttr, "ellipse", parseEnum[Shape])
+}
+
+// ShapeFile returns the parsed value of shapefile attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) ShapeFile() (string, bool) {
+	return attributeValue(n.GetStr, shapeFileAttr, "", parseString)
+}
+
+// ShowBoxes returns the parsed value of showboxes attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (g *Graph) ShowBoxes() (int, bool) {
+	return attributeValue(g.GetStr, showBoxesAttr, "0", parseInt)
+}
+
+// ShowBoxes returns the parsed value of showboxes attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (n *Node) ShowBoxes() (int, bool) {
+	return attributeValue(n.GetStr, showBoxesAttr, "0", parseInt)
+}
+
+// ShowBoxes returns the parsed value of showboxes attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (e *Edge) ShowBoxes() (int, bool) {
+	return attributeValue(e.GetStr, showBoxesAttr, "0", parseInt)
+}
+
+// Sides returns the parsed value of sides attribute.
+// If it is not set or cannot be parsed, the default value "4" is returned with false.
+func (n *Node) Sides() (int, bool) {
+	return attributeValue(n.GetStr, sidesAttr, "4", parseInt)
+}
+
+// Size returns the parsed value of size attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Size() (Point, bool) {
+	return attributeValue(g.GetStr, sizeAttr, "", parsePoint)
+}
+
+// Skew returns the parsed value of skew attribute.
+// If it is not set or cannot be parsed, the default value "0.0" is returned with false.
+func (n *Node) Skew() (float64, bool) {
+	return attributeValue(n.GetStr, skewAttr, "0.0", parseDouble)
+}
+
+// Smoothing returns the parsed value of smoothing attribute.
+// If it is not set or cannot be parsed, the default value "none" is returned with false.
+func (g *Graph) Smoothing() (SmoothType, bool) {
+	return attributeValue(g.GetStr, smoothingAttr, "none", parseEnum[SmoothType])
+}
+
+// Sortv returns the parsed value of sortv attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (g *Graph) Sortv() (int, bool) {
+	return attributeValue(g.GetStr, sortvAttr, "0", parseInt)
+}
+
+// Sortv returns the parsed value of sortv attribute.
+// If it is not set or cannot be parsed, the default value "0" is returned with false.
+func (n *Node) Sortv() (int, bool) {
+	return attributeValue(n.GetStr, sortvAttr, "0", parseInt)
+}
+
+// Splines returns the parsed value of splines attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Splines() (string, bool) {
+	return attributeValue(g.GetStr, splinesAttr, "", parseString)
+}
+
+// Start returns the parsed value of start attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Start() (StartType, bool) {
+	return attributeValue(g.GetStr, startAttr, "", parseEnum[StartType])
+}
+
+// Style returns the parsed value of style attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Style() ([]GraphStyle, bool) {
+	return attributeValue(g.GetStr, styleAttr, "", parseEnumList[GraphStyle])
+}
+
+// Style returns the parsed value of style attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Style() ([]NodeStyle, bool) {
+	return attributeValue(n.GetStr, styleAttr, "", parseEnumList[NodeStyle])
+}
+
+// Style returns the parsed value of style attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Style() ([]EdgeStyle, bool) {
+	return attributeValue(e.GetStr, styleAttr, "", parseEnumList[EdgeStyle])
+}
+
+// StyleSheet returns the parsed value of stylesheet attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) StyleSheet() (string, bool) {
+	return attributeValue(g.GetStr, stylesheetAttr, "", parseString)
+}
+
+// TailURL returns the parsed value of tailURL attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) TailURL() (string, bool) {
+	return attributeValue(e.GetStr, tailURLAttr, "", parseString)
+}
+
+// TailLabelPoint returns the parsed value of tail_lp attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) TailLabelPoint() (Point, bool) {
+	return attributeValue(e.GetStr, tailLpAttr, "", parsePoint)
+}
+
+// TailClip returns the parsed value of tailclip attribute.
+// If it is not set or cannot be parsed, the default value "true" is returned with false.
+func (e *Edge) TailClip() (bool, bool) {
+	return attributeValue(e.GetStr, tailClipAttr, "true", parseBool)
+}
+
+// TailHref returns the parsed value of tailhref attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) TailHref() (string, bool) {
+	return attributeValue(e.GetStr, tailHrefAttr, "", parseString)
+}
+
+// TailLabel returns the parsed value of taillabel attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) TailLabel() (string, bool) {
+	return attributeValue(e.GetStr, tailLabelAttr, "", parseString)
+}
+
+// TailPort returns the parsed value of tailport attribute.
+// If it is not set or cannot be parsed, the default value "center" is returned with false.
+func (e *Edge) TailPort() (string, bool) {
+	return attributeValue(e.GetStr, tailPortAttr, "center", parseString)
+}
+
+// TailTarget returns the parsed value of tailtarget attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) TailTarget() (string, bool) {
+	return attributeValue(e.GetStr, tailTargetAttr, "", parseString)
+}
+
+// TailTooltip returns the parsed value of tailtooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) TailTooltip() (string, bool) {
+	return attributeValue(e.GetStr, tailTooltipAttr, "", parseString)
+}
+
+// Target returns the parsed value of target attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Target() (string, bool) {
+	return attributeValue(g.GetStr, targetAttr, "", parseString)
+}
+
+// Target returns the parsed value of target attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Target() (string, bool) {
+	return attributeValue(n.GetStr, targetAttr, "", parseString)
+}
+
+// Target returns the parsed value of target attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Target() (string, bool) {
+	return attributeValue(e.GetStr, targetAttr, "", parseString)
+}
+
+// Tooltip returns the parsed value of tooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Tooltip() (string, bool) {
+	return attributeValue(g.GetStr, tooltipAttr, "", parseString)
+}
+
+// Tooltip returns the parsed value of tooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Tooltip() (string, bool) {
+	return attributeValue(n.GetStr, tooltipAttr, "", parseString)
+}
+
+// Tooltip returns the parsed value of tooltip attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) Tooltip() (string, bool) {
+	return attributeValue(e.GetStr, tooltipAttr, "", parseString)
+}
+
+// TrueColor returns the parsed value of truecolor attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) TrueColor() (bool, bool) {
+	return attributeValue(g.GetStr, trueColorAttr, "", parseBool)
+}
+
+// Vertices returns the parsed value of vertices attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) Vertices() ([]Point, bool) {
+	return attributeValue(n.GetStr, verticesAttr, "", parsePointList)
+}
+
+// Viewport returns the parsed value of viewport attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) Viewport() (string, bool) {
+	return attributeValue(g.GetStr, viewportAttr, "", parseString)
+}
+
+// VoroMargin returns the parsed value of voro_margin attribute.
+// If it is not set or cannot be parsed, the default value "0.05" is returned with false.
+func (g *Graph) VoroMargin() (float64, bool) {
+	return attributeValue(g.GetStr, voroMarginAttr, "0.05", parseDouble)
+}
+
+// Weight returns the parsed value of weight attribute.
+// If it is not set or cannot be parsed, the default value "1" is returned with false.
+func (e *Edge) Weight() (float64, bool) {
+	return attributeValue(e.GetStr, weightAttr, "1", parseDouble)
+}
+
+// Width returns the parsed value of width attribute.
+// If it is not set or cannot be parsed, the default value "0.75" is returned with false.
+func (n *Node) Width() (float64, bool) {
+	return attributeValue(n.GetStr, widthAttr, "0.75", parseDouble)
+}
+
+// XDotVersion returns the parsed value of xdotversion attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (g *Graph) XDotVersion() (string, bool) {
+	return attributeValue(g.GetStr, xdotVersionAttr, "", parseString)
+}
+
+// XLabel returns the parsed value of xlabel attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) XLabel() (string, bool) {
+	return attributeValue(n.GetStr, xlabelAttr, "", parseString)
+}
+
+// XLabel returns the parsed value of xlabel attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) XLabel() (string, bool) {
+	return attributeValue(e.GetStr, xlabelAttr, "", parseString)
+}
+
+// XLabelPosition returns the parsed value of xlp attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (n *Node) XLabelPosition() (Point, bool) {
+	return attributeValue(n.GetStr, xlpAttr, "", parsePoint)
+}
+
+// XLabelPosition returns the parsed value of xlp attribute.
+// If it is not set or cannot be parsed, the zero value is returned with false.
+func (e *Edge) XLabelPosition() (Point, bool) {
+	return attributeValue(e.GetStr, xlpAttr, "", parsePoint)
+}
+
+// Z returns the parsed value of z attribute.
+// If it is not set or cannot be parsed, the default value "0.0" is returned with false.
+func (n *Node) Z() (float64, bool) {
+	return attributeValue(n.GetStr, zAttr, "0.0", parseDouble)
+}
@@ -0,0 +1,206 @@
+package cgraph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AttributeKind is a kind of object an attribute applies to.
+// The values are the letters used in https://graphviz.org/doc/info/attrs.html .
+type AttributeKind string
+
+const (
+	GraphKind    AttributeKind = "G"
+	SubGraphKind AttributeKind = "S"
+	ClusterKind  AttributeKind = "C"
+	NodeKind     AttributeKind = "N"
+	EdgeKind     AttributeKind = "E"
+)
+
+var attributeKinds = []struct {
+	usage attributeUsage
+	kind  AttributeKind
+}{
+	{graphUsage, GraphKind},
+	{subgraphUsage, SubGraphKind},
+	{clusterUsage, ClusterKind},
+	{nodeUsage, NodeKind},
+	{edgeUsage, EdgeKind},
+}
+
+// layoutEngines are the names of the layout engines in the order of layoutEngine bits.
+var layoutEngines = []string{"dot", "neato", "fdp", "sfdp", "circo", "twopi", "osage", "patchwork"}
+
+// AttributeSpec describes an attribute.
+type AttributeSpec struct {
+	Name string
+	// Kinds are the kinds of objects the attribute applies to.
+	Kinds []AttributeKind
+	// Type is the name of the type described in https://graphviz.org/docs/attr-types/ . e.g. "colorList".
+	Type string
+	// Default is the default value used by Graphviz. It is empty if there is no default.
+	Default string
+	// Minimum is the minimum value of a numeric attribute. It is nil if there is no limit.
+	Minimum *float64
+	// Engines are the names of the layout engines using the attribute. It is empty if all engines use it.
+	Engines []string
+}
+
+// UsedBy reports whether the layout engine uses the attribute.
+func (s *AttributeSpec) UsedBy(layout string) bool {
+	if len(s.Engines) == 0 {
+		return true
+	}
+	for _, e := range s.Engines {
+		if e == layout {
+			return true
+		}
+	}
+	return false
+}
+
+// AppliesTo reports whether the attribute applies to the kind of object.
+func (s *AttributeSpec) AppliesTo(kind AttributeKind) bool {
+	for _, k := range s.Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// AttributeInfo returns the specs of the attribute.
+// Most attributes have a single spec, but an attribute whose type or default value depends on the kind of object
+// ( e.g. label, pos and style ) has a spec for each group of kinds.
+// It returns nil if name is not a known attribute.
+func AttributeInfo(name string) []*AttributeSpec {
+	var ret []*AttributeSpec
+	for _, s := range attributeSchemas {
+		if string(s.name) == name {
+			ret = append(ret, s.spec())
+		}
+	}
+	return ret
+}
+
+// AttributeSpecs returns the specs of all known attributes.
+func AttributeSpecs() []*AttributeSpec {
+	ret := make([]*AttributeSpec, 0, len(attributeSchemas))
+	for _, s := range attributeSchemas {
+		ret = append(ret, s.spec())
+	}
+	return ret
+}
+
+func (s *attributeSchema) spec() *AttributeSpec {
+	spec := &AttributeSpec{
+		Name:    string(s.name),
+		Type:    string(s.typ),
+		Default: s.def,
+	}
+	for _, k := range attributeKinds {
+		if s.usage&k.usage != 0 {
+			spec.Kinds = append(spec.Kinds, k.kind)
+		}
+	}
+	if s.minimum != "" {
+		minimum, _ := strconv.ParseFloat(s.minimum, 64)
+		spec.Minimum = &minimum
+	}
+	for i, e := range layoutEngines {
+		if s.engines&(1<<i) != 0 {
+			spec.Engines = append(spec.Engines, e)
+		}
+	}
+	return spec
+}
+
+// validateMinimum reports whether the numeric value is greater than or equal to the minimum of the attribute.
+func (s *attributeSchema) validateMinimum(v string) error {
+	if s.minimum == "" || (s.typ != intValue && s.typ != doubleValue) {
+		return nil
+	}
+	d, err := parseDouble(v)
+	if err != nil {
+		return err
+	}
+	minimum, _ := strconv.ParseFloat(s.minimum, 64)
+	if d < minimum {
+		return fmt.Errorf("%w: %g is less than the minimum %s", ErrOutOfRange, d, s.minimum)
+	}
+	return nil
+}
+
+// AttributeWarning reports an attribute which has no effect on the layout.
+type AttributeWarning struct {
+	// Kind is the kind of objects the attribute is declared for. It is GRAPH, NODE or EDGE.
+	Kind ObjectTag
+	Name string
+	// Reason describes why the attribute is ignored.
+	Reason string
+}
+
+func (w *AttributeWarning) String() string {
+	return fmt.Sprintf("%s attribute %s is ignored: %s", objectTagName(w.Kind), w.Name, w.Reason)
+}
+
+// IgnoredAttributes returns the attributes declared in the graph which are ignored by the layout engine:
+// attributes the engine does not use, attributes which do not apply to the kind of object they are declared for,
+// and unknown attributes.
+// Attributes starting with "_" such as "_draw_" are output of the renderers and are not reported.
+func (g *Graph) IgnoredAttributes(layout string) ([]*AttributeWarning, error) {
+	var ret []*AttributeWarning
+	for _, k := range []struct {
+		tag   ObjectTag
+		usage attributeUsage
+	}{
+		{GRAPH, graphUsage | subgraphUsage | clusterUsage},
+		{NODE, nodeUsage},
+		{EDGE, edgeUsage},
+	} {
+		for sym, err := range g.Attributes(k.tag) {
+			if err != nil {
+				return nil, err
+			}
+			if reason := ignoredReason(attribute(sym.Name()), k.tag, k.usage, layout); reason != "" {
+				ret = append(ret, &AttributeWarning{Kind: k.tag, Name: sym.Name(), Reason: reason})
+			}
+		}
+	}
+	return ret, nil
+}
+
+func ignoredReason(name attribute, tag ObjectTag, usage attributeUsage, layout string) string {
+	s := lookupAttributeSchema(name, usage)
+	if s == nil {
+		if name == "" || strings.HasPrefix(string(name), "_") {
+			return ""
+		}
+		return "unknown attribute"
+	}
+	if s.usage&usage == 0 {
+		return fmt.Sprintf("not applicable to %s", objectTagName(tag))
+	}
+	spec := s.spec()
+	engine := layout
+	switch layout {
+	case "nop", "nop1", "nop2":
+		// nop layouts are neato -n.
+		engine = "neato"
+	}
+	if !spec.UsedBy(engine) {
+		return fmt.Sprintf("used only by %s", strings.Join(spec.Engines, ", "))
+	}
+	return ""
+}
+
+func objectTagName(tag ObjectTag) string {
+	switch tag {
+	case GRAPH:
+		return "graph"
+	case NODE:
+		return "node"
+	}
+	return "edge"
+}
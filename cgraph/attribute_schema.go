@@ -0,0 +1,279 @@
+package cgraph
+
+//go:generate go run ../internal/tools/attrgen
+
+// attributeUsage is a set of objects an attribute can be applied to.
+type attributeUsage int
+
+const (
+	graphUsage attributeUsage = 1 << iota
+	subgraphUsage
+	clusterUsage
+	nodeUsage
+	edgeUsage
+)
+
+// layoutEngine is a set of layout engines an attribute is used by.
+type layoutEngine int
+
+const (
+	dotEngine layoutEngine = 1 << iota
+	neatoEngine
+	fdpEngine
+	sfdpEngine
+	circoEngine
+	twopiEngine
+	osageEngine
+	patchworkEngine
+)
+
+// valueType is a type of attribute value described in https://graphviz.org/docs/attr-types/ .
+type valueType string
+
+const (
+	stringValue      valueType = "string"
+	escStringValue   valueType = "escString"
+	lblStringValue   valueType = "lblString"
+	boolValue        valueType = "bool"
+	intValue         valueType = "int"
+	doubleValue      valueType = "double"
+	pointValue       valueType = "point"
+	pointListValue   valueType = "pointList"
+	rectValue        valueType = "rect"
+	splineTypeValue  valueType = "splineType"
+	colorValue       valueType = "color"
+	colorListValue   valueType = "colorList"
+	arrowTypeValue   valueType = "arrowType"
+	clusterModeValue valueType = "clusterMode"
+	dirTypeValue     valueType = "dirType"
+	imagePosValue    valueType = "imagePos"
+	imageScaleValue  valueType = "imageScale"
+	justValue        valueType = "labeljust"
+	labelLocValue    valueType = "labelloc"
+	modeValue        valueType = "mode"
+	modelValue       valueType = "model"
+	orderingValue    valueType = "ordering"
+	outputModeValue  valueType = "outputMode"
+	packModeValue    valueType = "packMode"
+	pageDirValue     valueType = "pagedir"
+	quadTypeValue    valueType = "quadType"
+	rankDirValue     valueType = "rankdir"
+	ratioValue       valueType = "ratio"
+	shapeValue       valueType = "shape"
+	smoothTypeValue  valueType = "smoothType"
+	startTypeValue   valueType = "startType"
+	graphStyleValue  valueType = "graphStyle"
+	nodeStyleValue   valueType = "nodeStyle"
+	edgeStyleValue   valueType = "edgeStyle"
+	tbBalanceValue   valueType = "TBbalance"
+)
+
+// attributeSchema describes an attribute.
+// An attribute whose type or default value depends on the object has an entry for each usage.
+//
+// This table is the source of attribute_getter.go and attribute_setter.go. Run go generate after editing it.
+type attributeSchema struct {
+	name  attribute
+	usage attributeUsage
+	typ   valueType
+	// def is the default value used by Graphviz.
+	def string
+	// minimum is the minimum value of a numeric attribute. It is empty if there is no limit.
+	minimum string
+	// engines is the set of layout engines using the attribute. It is 0 if all engines use it.
+	engines layoutEngine
+	// getter is the name of the generated getter. The getter is not generated if it is empty.
+	getter string
+	// setter is the name of the generated setter.
+	// It is set only for the attributes which do not have a hand-written setter in attribute.go.
+	setter string
+}
+
+var attributeSchemas = []*attributeSchema{
+	{name: dampingAttr, usage: graphUsage, typ: doubleValue, def: "0.99", getter: "Damping", minimum: "0", engines: neatoEngine},
+	{name: kAttr, usage: graphUsage | clusterUsage, typ: doubleValue, def: "0.3", getter: "K", minimum: "0", engines: fdpEngine | sfdpEngine},
+	{name: tbBalanceAttr, usage: graphUsage, typ: tbBalanceValue, getter: "TBBalance", setter: "SetTBBalance", engines: dotEngine},
+	{name: urlAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: escStringValue, getter: "URL"},
+	{name: backgroundAttr, usage: graphUsage, typ: stringValue, getter: "Background"},
+	{name: areaAttr, usage: nodeUsage | clusterUsage, typ: doubleValue, def: "1.0", getter: "Area", minimum: "0", engines: patchworkEngine},
+	{name: arrowHeadAttr, usage: edgeUsage, typ: arrowTypeValue, def: "normal", getter: "ArrowHead"},
+	{name: arrowSizeAttr, usage: edgeUsage, typ: doubleValue, def: "1.0", getter: "ArrowSize", minimum: "0"},
+	{name: arrowTailAttr, usage: edgeUsage, typ: arrowTypeValue, def: "normal", getter: "ArrowTail"},
+	{name: bbAttr, usage: graphUsage | clusterUsage, typ: rectValue, getter: "BB"},
+	{name: beautifyAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Beautify", setter: "SetBeautify", engines: sfdpEngine},
+	{name: bgcolorAttr, usage: graphUsage | clusterUsage, typ: colorListValue, getter: "BackgroundColor"},
+	{name: centerAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Center"},
+	{name: charsetAttr, usage: graphUsage, typ: stringValue, def: "UTF-8", getter: "Charset"},
+	{name: classAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: stringValue, getter: "Class", setter: "SetClass"},
+	{name: clusterAttr, usage: clusterUsage | subgraphUsage, typ: boolValue, def: "false", getter: "Cluster", setter: "SetCluster"},
+	{name: clusterRankAttr, usage: graphUsage, typ: clusterModeValue, def: "local", getter: "ClusterRank", engines: dotEngine},
+	{name: colorAttr, usage: clusterUsage | nodeUsage | edgeUsage, typ: colorListValue, def: "black", getter: "Color"},
+	{name: colorSchemeAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: stringValue, getter: "ColorScheme"},
+	{name: commentAttr, usage: graphUsage | nodeUsage | edgeUsage, typ: stringValue, getter: "Comment"},
+	{name: compoundAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Compound", engines: dotEngine},
+	{name: concentrateAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Concentrate"},
+	{name: constraintAttr, usage: edgeUsage, typ: boolValue, def: "true", getter: "Constraint", engines: dotEngine},
+	{name: decorateAttr, usage: edgeUsage, typ: boolValue, def: "false", getter: "Decorate"},
+	{name: defaultDistAttr, usage: graphUsage, typ: doubleValue, getter: "DefaultDist", engines: neatoEngine},
+	{name: dimAttr, usage: graphUsage, typ: intValue, def: "2", getter: "Dim", minimum: "2", engines: neatoEngine | fdpEngine | sfdpEngine},
+	{name: dimenAttr, usage: graphUsage, typ: intValue, def: "2", getter: "Dimen", minimum: "2", engines: neatoEngine | fdpEngine | sfdpEngine},
+	{name: dirAttr, usage: edgeUsage, typ: dirTypeValue, def: "forward", getter: "Dir"},
+	{name: dirEdgeConstraintsAttr, usage: graphUsage, typ: stringValue, def: "false", getter: "DirEdgeConstraints", engines: neatoEngine},
+	{name: distortionAttr, usage: nodeUsage, typ: doubleValue, def: "0.0", getter: "Distortion", minimum: "-100"},
+	{name: dpiAttr, usage: graphUsage, typ: doubleValue, def: "96.0", getter: "DPI"},
+	{name: edgeURLAttr, usage: edgeUsage, typ: escStringValue, getter: "EdgeURL"},
+	{name: edgeHrefAttr, usage: edgeUsage, typ: escStringValue, getter: "EdgeHref"},
+	{name: edgeTargetAttr, usage: edgeUsage, typ: escStringValue, getter: "EdgeTarget"},
+	{name: edgeTooltipAttr, usage: edgeUsage, typ: escStringValue, getter: "EdgeTooltip"},
+	{name: epsilonAttr, usage: graphUsage, typ: doubleValue, def: ".0001", getter: "Epsilon", engines: neatoEngine},
+	{name: esepAttr, usage: graphUsage, typ: pointValue, def: "+3", getter: "ESep", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: fillColorAttr, usage: nodeUsage | edgeUsage | clusterUsage, typ: colorListValue, def: "lightgrey", getter: "FillColor"},
+	{name: fixedSizeAttr, usage: nodeUsage, typ: boolValue, def: "false"},
+	{name: fontColorAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: colorValue, def: "black", getter: "FontColor"},
+	{name: fontNameAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: stringValue, def: "Times-Roman", getter: "FontName"},
+	{name: fontNamesAttr, usage: graphUsage, typ: stringValue, getter: "FontNames"},
+	{name: fontPathAttr, usage: graphUsage, typ: stringValue, getter: "FontPath"},
+	{name: fontSizeAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: doubleValue, def: "14.0", getter: "FontSize", minimum: "1"},
+	{name: forceLabelsAttr, usage: graphUsage, typ: boolValue, def: "true", getter: "ForceLabels"},
+	{name: gradientAngleAttr, usage: nodeUsage | clusterUsage | graphUsage, typ: intValue, getter: "GradientAngle"},
+	{name: groupAttr, usage: nodeUsage, typ: stringValue, getter: "Group", engines: dotEngine},
+	{name: headURLAttr, usage: edgeUsage, typ: escStringValue, getter: "HeadURL"},
+	{name: headLpAttr, usage: edgeUsage, typ: pointValue, getter: "HeadLabelPoint"},
+	{name: headClipAttr, usage: edgeUsage, typ: boolValue, def: "true", getter: "HeadClip"},
+	{name: headHrefAttr, usage: edgeUsage, typ: escStringValue, getter: "HeadHref"},
+	{name: headLabelAttr, usage: edgeUsage, typ: lblStringValue, getter: "HeadLabel"},
+	{name: headPortAttr, usage: edgeUsage, typ: stringValue, def: "center", getter: "HeadPort"},
+	{name: headTargetAttr, usage: edgeUsage, typ: escStringValue, getter: "HeadTarget"},
+	{name: headTooltipAttr, usage: edgeUsage, typ: escStringValue, getter: "HeadTooltip"},
+	{name: heightAttr, usage: nodeUsage, typ: doubleValue, def: "0.5", getter: "Height", minimum: "0.02"},
+	{name: hrefAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: escStringValue, getter: "Href"},
+	{name: idAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: escStringValue, getter: "ID"},
+	{name: imageAttr, usage: nodeUsage, typ: stringValue, getter: "Image"},
+	{name: imagePathAttr, usage: graphUsage, typ: stringValue, getter: "ImagePath"},
+	{name: imagePosAttr, usage: nodeUsage, typ: imagePosValue, def: "mc", getter: "ImagePos"},
+	{name: imageScaleAttr, usage: nodeUsage, typ: imageScaleValue, def: "false"},
+	{name: inputScaleAttr, usage: graphUsage, typ: doubleValue, getter: "InputScale", engines: neatoEngine | fdpEngine},
+	{name: labelAttr, usage: graphUsage | clusterUsage, typ: lblStringValue, def: `\G`},
+	{name: labelAttr, usage: nodeUsage, typ: lblStringValue, def: `\N`},
+	{name: labelAttr, usage: edgeUsage, typ: lblStringValue, def: `\E`},
+	{name: labelURLAttr, usage: edgeUsage, typ: escStringValue, getter: "LabelURL"},
+	{name: labelSchemeAttr, usage: graphUsage, typ: intValue, def: "0", getter: "LabelScheme", engines: sfdpEngine},
+	{name: labelAngleAttr, usage: edgeUsage, typ: doubleValue, def: "-25.0", getter: "LabelAngle", minimum: "-180"},
+	{name: labelDistanceAttr, usage: edgeUsage, typ: doubleValue, def: "1.0", getter: "LabelDistance", minimum: "0"},
+	{name: labelFloatAttr, usage: edgeUsage, typ: boolValue, def: "false", getter: "LabelFloat"},
+	{name: labelFontColorAttr, usage: edgeUsage, typ: colorValue, def: "black", getter: "LabelFontColor"},
+	{name: labelFontNameAttr, usage: edgeUsage, typ: stringValue, def: "Times-Roman", getter: "LabelFontName"},
+	{name: labelFontSizeAttr, usage: edgeUsage, typ: doubleValue, def: "14.0", getter: "LabelFontSize", minimum: "1"},
+	{name: labelHrefAttr, usage: edgeUsage, typ: escStringValue, getter: "LabelHref"},
+	{name: labelJustAttr, usage: graphUsage | clusterUsage, typ: justValue, def: "c", getter: "LabelJust"},
+	{name: labelLocAttr, usage: graphUsage | clusterUsage, typ: labelLocValue, def: "b", getter: "LabelLocation"},
+	{name: labelLocAttr, usage: nodeUsage, typ: labelLocValue, def: "c", getter: "LabelLocation"},
+	{name: labelTargetAttr, usage: edgeUsage, typ: escStringValue, getter: "LabelTarget"},
+	{name: labelTooltipAttr, usage: edgeUsage, typ: escStringValue, getter: "LabelTooltip"},
+	{name: landscapeAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Landscape"},
+	{name: layerAttr, usage: edgeUsage | nodeUsage | clusterUsage, typ: stringValue, getter: "Layer"},
+	{name: layerListSepAttr, usage: graphUsage, typ: stringValue, def: ",", getter: "LayerListSeparator"},
+	{name: layersAttr, usage: graphUsage, typ: stringValue, getter: "Layers"},
+	{name: layerSelectAttr, usage: graphUsage, typ: stringValue, getter: "LayerSelect"},
+	{name: layerSepAttr, usage: graphUsage, typ: stringValue, def: ":\t ", getter: "LayerSeparator"},
+	{name: layoutAttr, usage: graphUsage, typ: stringValue, getter: "Layout"},
+	{name: lenAttr, usage: edgeUsage, typ: doubleValue, def: "1.0", getter: "Len", engines: neatoEngine | fdpEngine},
+	{name: levelsAttr, usage: graphUsage, typ: intValue, def: "2147483647", getter: "Levels", minimum: "0", engines: sfdpEngine},
+	{name: levelsGapAttr, usage: graphUsage, typ: doubleValue, def: "0.0", getter: "LevelsGap", engines: neatoEngine},
+	{name: lHeadAttr, usage: edgeUsage, typ: stringValue, getter: "LogicalHead", engines: dotEngine},
+	{name: lHeightAttr, usage: graphUsage | clusterUsage, typ: doubleValue, getter: "LabelHeight"},
+	{name: lineLengthAttr, usage: graphUsage, typ: intValue, def: "128", minimum: "60", getter: "LineLength", setter: "SetLineLength"},
+	{name: lpAttr, usage: edgeUsage | graphUsage | clusterUsage, typ: pointValue, getter: "LabelPosition"},
+	{name: lTailAttr, usage: edgeUsage, typ: stringValue, getter: "LogicalTail", engines: dotEngine},
+	{name: lWidthAttr, usage: graphUsage | clusterUsage, typ: doubleValue, getter: "LabelWidth"},
+	{name: marginAttr, usage: nodeUsage | clusterUsage | graphUsage, typ: pointValue, getter: "Margin"},
+	{name: maxIterAttr, usage: graphUsage, typ: intValue, getter: "MaxIterator", engines: neatoEngine | fdpEngine},
+	{name: mcLimitAttr, usage: graphUsage, typ: doubleValue, def: "1.0", getter: "MCLimit", engines: dotEngine},
+	{name: minDistAttr, usage: graphUsage, typ: doubleValue, def: "1.0", getter: "MinDist", minimum: "0", engines: circoEngine},
+	{name: minLenAttr, usage: edgeUsage, typ: intValue, def: "1", getter: "MinLen", minimum: "0", engines: dotEngine},
+	{name: modeAttr, usage: graphUsage, typ: modeValue, def: "major", getter: "Mode", engines: neatoEngine},
+	{name: modelAttr, usage: graphUsage, typ: modelValue, def: "shortpath", getter: "Model", engines: neatoEngine},
+	{name: mosekAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Mosek", engines: neatoEngine},
+	{name: newRankAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "NewRank", engines: dotEngine},
+	{name: nodeSepAttr, usage: graphUsage, typ: doubleValue, def: "0.25", getter: "NodeSeparator", minimum: "0.02"},
+	{name: noJustifyAttr, usage: graphUsage | clusterUsage | nodeUsage | edgeUsage, typ: boolValue, def: "false", getter: "NoJustify"},
+	{name: normalizeAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Normalize", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: noTranslateAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "NoTranslate", engines: neatoEngine},
+	{name: nsLimitAttr, usage: graphUsage, typ: doubleValue, getter: "NsLimit", engines: dotEngine},
+	{name: nsLimit1Attr, usage: graphUsage, typ: doubleValue, getter: "NsLimit1", engines: dotEngine},
+	{name: oneBlockAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "OneBlock", setter: "SetOneBlock", engines: circoEngine},
+	{name: orderingAttr, usage: graphUsage | nodeUsage, typ: orderingValue, getter: "Ordering", engines: dotEngine},
+	{name: orientationAttr, usage: graphUsage, typ: stringValue, getter: "Orientation"},
+	{name: orientationAttr, usage: nodeUsage, typ: doubleValue, def: "0.0", getter: "Orientation"},
+	{name: outputOrderAttr, usage: graphUsage, typ: outputModeValue, def: "breadthfirst", getter: "OutputOrder"},
+	{name: overlapAttr, usage: graphUsage, typ: stringValue, def: "true", getter: "Overlap", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: overlapScalingAttr, usage: graphUsage, typ: doubleValue, def: "-4", getter: "OverlapScaling", minimum: "-1e10", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: overlapShrinkAttr, usage: graphUsage, typ: boolValue, def: "true", getter: "OverlapShrink", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: packAttr, usage: graphUsage, typ: boolValue, def: "false", getter: "Pack", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: packModeAttr, usage: graphUsage, typ: packModeValue, def: "node", getter: "PackMode", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: padAttr, usage: graphUsage, typ: pointValue, def: "0.0555", getter: "Pad"},
+	{name: pageAttr, usage: graphUsage, typ: pointValue, getter: "Page"},
+	{name: pageDirAttr, usage: graphUsage, typ: pageDirValue, def: "BL", getter: "PageDir"},
+	{name: penColorAttr, usage: clusterUsage, typ: colorValue, def: "black", getter: "PenColor"},
+	{name: penWidthAttr, usage: clusterUsage | nodeUsage | edgeUsage, typ: doubleValue, def: "1.0", getter: "PenWidth", minimum: "0"},
+	{name: peripheriesAttr, usage: nodeUsage | clusterUsage, typ: intValue, def: "1", getter: "Peripheries", minimum: "0"},
+	{name: pinAttr, usage: nodeUsage, typ: boolValue, def: "false", getter: "Pin", engines: neatoEngine | fdpEngine},
+	{name: posAttr, usage: nodeUsage, typ: pointValue, getter: "Pos"},
+	{name: posAttr, usage: edgeUsage, typ: splineTypeValue, getter: "Pos"},
+	{name: quadTreeAttr, usage: graphUsage, typ: quadTypeValue, def: "normal", getter: "QuadTree", engines: sfdpEngine},
+	{name: quantumAttr, usage: graphUsage, typ: doubleValue, def: "0.0", getter: "Quantum", minimum: "0"},
+	{name: rankAttr, usage: subgraphUsage, typ: stringValue, getter: "Rank", engines: dotEngine},
+	{name: rankDirAttr, usage: graphUsage, typ: rankDirValue, def: "TB", getter: "RankDir", engines: dotEngine},
+	{name: rankSepAttr, usage: graphUsage, typ: doubleValue, def: "0.5", getter: "RankSeparator", minimum: "0.02", engines: dotEngine | twopiEngine},
+	{name: ratioAttr, usage: graphUsage, typ: ratioValue, getter: "Ratio"},
+	{name: rectsAttr, usage: nodeUsage, typ: rectValue, getter: "Rects"},
+	{name: regularAttr, usage: nodeUsage, typ: boolValue, def: "false", getter: "Regular"},
+	{name: remincrossAttr, usage: graphUsage, typ: boolValue, def: "true", getter: "ReminCross", engines: dotEngine},
+	{name: repulsiveforceAttr, usage: graphUsage, typ: doubleValue, def: "1.0", getter: "RepulsiveForce", minimum: "0", engines: sfdpEngine},
+	{name: resolutionAttr, usage: graphUsage, typ: doubleValue, def: "96.0", getter: "Resolution"},
+	// Node has Root method returning the root graph, so the getters are named differently.
+	{name: rootAttr, usage: graphUsage, typ: stringValue, getter: "RootNode", engines: circoEngine | twopiEngine},
+	{name: rootAttr, usage: nodeUsage, typ: boolValue, def: "false", getter: "IsRoot", engines: circoEngine | twopiEngine},
+	{name: rotateAttr, usage: graphUsage, typ: intValue, def: "0", getter: "Rotate"},
+	{name: rotationAttr, usage: graphUsage, typ: doubleValue, def: "0", getter: "Rotation", engines: sfdpEngine},
+	{name: sameHeadAttr, usage: edgeUsage, typ: stringValue, getter: "SameHead", engines: dotEngine},
+	{name: sameTailAttr, usage: edgeUsage, typ: stringValue, getter: "SameTail", engines: dotEngine},
+	{name: samplePointsAttr, usage: nodeUsage, typ: intValue, def: "8", getter: "SamplePoints"},
+	{name: scaleAttr, usage: graphUsage, typ: pointValue, getter: "Scale", engines: neatoEngine | twopiEngine},
+	{name: searchSizeAttr, usage: graphUsage, typ: intValue, def: "30", getter: "SearchSize", engines: dotEngine},
+	{name: sepAttr, usage: graphUsage, typ: pointValue, def: "+4", getter: "Separator", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: shapeAttr, usage: nodeUsage, typ: shapeValue, def: "ellipse", getter: "Shape"},
+	{name: shapeFileAttr, usage: nodeUsage, typ: stringValue, getter: "ShapeFile"},
+	{name: showBoxesAttr, usage: edgeUsage | nodeUsage | graphUsage, typ: intValue, def: "0", getter: "ShowBoxes", minimum: "0", engines: dotEngine},
+	{name: sidesAttr, usage: nodeUsage, typ: intValue, def: "4", getter: "Sides", minimum: "3"},
+	{name: sizeAttr, usage: graphUsage, typ: pointValue, getter: "Size"},
+	{name: skewAttr, usage: nodeUsage, typ: doubleValue, def: "0.0", getter: "Skew", minimum: "-100"},
+	{name: smoothingAttr, usage: graphUsage, typ: smoothTypeValue, def: "none", getter: "Smoothing", engines: sfdpEngine},
+	{name: sortvAttr, usage: graphUsage | clusterUsage | nodeUsage, typ: intValue, def: "0", getter: "Sortv"},
+	{name: splinesAttr, usage: graphUsage, typ: stringValue, getter: "Splines"},
+	{name: startAttr, usage: graphUsage, typ: startTypeValue, getter: "Start", engines: neatoEngine | fdpEngine},
+	{name: styleAttr, usage: graphUsage | clusterUsage, typ: graphStyleValue, getter: "Style"},
+	{name: styleAttr, usage: nodeUsage, typ: nodeStyleValue, getter: "Style"},
+	{name: styleAttr, usage: edgeUsage, typ: edgeStyleValue, getter: "Style"},
+	{name: stylesheetAttr, usage: graphUsage, typ: stringValue, getter: "StyleSheet"},
+	{name: tailURLAttr, usage: edgeUsage, typ: escStringValue, getter: "TailURL"},
+	{name: tailLpAttr, usage: edgeUsage, typ: pointValue, getter: "TailLabelPoint"},
+	{name: tailClipAttr, usage: edgeUsage, typ: boolValue, def: "true", getter: "TailClip"},
+	{name: tailHrefAttr, usage: edgeUsage, typ: escStringValue, getter: "TailHref"},
+	{name: tailLabelAttr, usage: edgeUsage, typ: lblStringValue, getter: "TailLabel"},
+	{name: tailPortAttr, usage: edgeUsage, typ: stringValue, def: "center", getter: "TailPort"},
+	{name: tailTargetAttr, usage: edgeUsage, typ: escStringValue, getter: "TailTarget"},
+	{name: tailTooltipAttr, usage: edgeUsage, typ: escStringValue, getter: "TailTooltip"},
+	{name: targetAttr, usage: edgeUsage | nodeUsage | graphUsage | clusterUsage, typ: escStringValue, getter: "Target"},
+	{name: tooltipAttr, usage: nodeUsage | edgeUsage | clusterUsage, typ: escStringValue, getter: "Tooltip"},
+	{name: trueColorAttr, usage: graphUsage, typ: boolValue, getter: "TrueColor"},
+	{name: verticesAttr, usage: nodeUsage, typ: pointListValue, getter: "Vertices"},
+	{name: viewportAttr, usage: graphUsage, typ: stringValue, getter: "Viewport"},
+	{name: voroMarginAttr, usage: graphUsage, typ: doubleValue, def: "0.05", getter: "VoroMargin", minimum: "0", engines: neatoEngine | fdpEngine | sfdpEngine | circoEngine | twopiEngine},
+	{name: weightAttr, usage: edgeUsage, typ: doubleValue, def: "1", getter: "Weight", minimum: "0", engines: dotEngine | neatoEngine | fdpEngine},
+	{name: widthAttr, usage: nodeUsage, typ: doubleValue, def: "0.75", getter: "Width", minimum: "0.01"},
+	{name: xdotVersionAttr, usage: graphUsage, typ: stringValue, getter: "XDotVersion"},
+	{name: xlabelAttr, usage: edgeUsage | nodeUsage, typ: lblStringValue, getter: "XLabel"},
+	{name: xlpAttr, usage: nodeUsage | edgeUsage, typ: pointValue, getter: "XLabelPosition"},
+	{name: zAttr, usage: nodeUsage, typ: doubleValue, def: "0.0", getter: "Z"},
+}
@@ -0,0 +1,1351 @@
+// Code generated by attrgen. DO NOT EDIT.
+
+package cgraph
+
+import "fmt"
+
+// SetTBBalance sets TBbalance attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:TBbalance
+func (g *Graph) SetTBBalance(v string) *Graph {
+	g.SafeSet(string(tbBalanceAttr), v, "")
+	return g
+}
+
+// SetBeautify sets beautify attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:beautify
+func (g *Graph) SetBeautify(v bool) *Graph {
+	g.SafeSet(string(beautifyAttr), toBoolString(v), "false")
+	return g
+}
+
+// SetClass sets class attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:class
+func (g *Graph) SetClass(v string) *Graph {
+	g.SafeSet(string(classAttr), v, "")
+	return g
+}
+
+// SetClass sets class attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:class
+func (n *Node) SetClass(v string) *Node {
+	n.SafeSet(string(classAttr), v, "")
+	return n
+}
+
+// SetClass sets class attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:class
+func (e *Edge) SetClass(v string) *Edge {
+	e.SafeSet(string(classAttr), v, "")
+	return e
+}
+
+// SetCluster sets cluster attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:cluster
+func (g *Graph) SetCluster(v bool) *Graph {
+	g.SafeSet(string(clusterAttr), toBoolString(v), "false")
+	return g
+}
+
+// SetLineLength sets linelength attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:linelength
+func (g *Graph) SetLineLength(v int) *Graph {
+	g.SafeSet(string(lineLengthAttr), fmt.Sprint(v), "128")
+	return g
+}
+
+// SetOneBlock sets oneblock attribute.
+// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:oneblock
+func (g *Graph) SetOneBlock(v bool) *Graph {
+	g.SafeSet(string(oneBlockAttr), toBoolString(v), "false")
+	return g
+}
+
+// TrySetDamping is like SetDamping but validates the value against the type of Damping attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetDamping(v float64) error {
+	return g.trySet(dampingAttr, fmt.Sprint(v), "0.99")
+}
+
+// TrySetK is like SetK but validates the value against the type of K attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetK(v float64) error {
+	return g.trySet(kAttr, fmt.Sprint(v), "0.3")
+}
+
+// TrySetURL is like SetURL but validates the value against the type of URL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetURL(v string) error {
+	return g.trySet(urlAttr, v, "")
+}
+
+// TrySetURL is like SetURL but validates the value against the type of URL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetURL(v string) error {
+	return n.trySet(urlAttr, v, "")
+}
+
+// TrySetURL is like SetURL but validates the value against the type of URL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetURL(v string) error {
+	return e.trySet(urlAttr, v, "")
+}
+
+// TrySetBackground is like SetBackground but validates the value against the type of _background attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetBackground(v string) error {
+	return g.trySet(backgroundAttr, v, "")
+}
+
+// TrySetArea is like SetArea but validates the value against the type of area attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetArea(v float64) error {
+	return n.trySet(areaAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetArrowHead is like SetArrowHead but validates the value against the type of arrowhead attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetArrowHead(v ArrowType) error {
+	return e.trySet(arrowHeadAttr, string(v), string(NormalArrow))
+}
+
+// TrySetArrowSize is like SetArrowSize but validates the value against the type of arrowsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetArrowSize(v float64) error {
+	return e.trySet(arrowSizeAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetArrowTail is like SetArrowTail but validates the value against the type of arrowtail attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetArrowTail(v ArrowType) error {
+	return e.trySet(arrowTailAttr, string(v), string(NormalArrow))
+}
+
+// TrySetBB is like SetBB but validates the value against the type of bb attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetBB(llx, lly, urx, ury float64) error {
+	return g.trySet(bbAttr, fmt.Sprintf("%f,%f,%f,%f", llx, lly, urx, ury), "")
+}
+
+// TrySetBackgroundColor is like SetBackgroundColor but validates the value against the type of bgcolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetBackgroundColor(v string) error {
+	return g.trySet(bgcolorAttr, v, "")
+}
+
+// TrySetCenter is like SetCenter but validates the value against the type of center attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetCenter(v bool) error {
+	return g.trySet(centerAttr, toBoolString(v), falseStr)
+}
+
+// TrySetCharset is like SetCharset but validates the value against the type of charset attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetCharset(v string) error {
+	return g.trySet(charsetAttr, v, "UTF-8")
+}
+
+// TrySetClusterRank is like SetClusterRank but validates the value against the type of clusterrank attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetClusterRank(v ClusterMode) error {
+	return g.trySet(clusterRankAttr, string(v), string(LocalCluster))
+}
+
+// TrySetColor is like SetColor but validates the value against the type of color attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetColor(v string) error {
+	return n.trySet(colorAttr, v, "black")
+}
+
+// TrySetColor is like SetColor but validates the value against the type of color attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetColor(v string) error {
+	return e.trySet(colorAttr, v, "black")
+}
+
+// TrySetColorScheme is like SetColorScheme but validates the value against the type of colorscheme attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetColorScheme(v string) error {
+	return g.trySet(colorSchemeAttr, v, "")
+}
+
+// TrySetColorScheme is like SetColorScheme but validates the value against the type of colorscheme attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetColorScheme(v string) error {
+	return n.trySet(colorSchemeAttr, v, "")
+}
+
+// TrySetColorScheme is like SetColorScheme but validates the value against the type of colorscheme attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetColorScheme(v string) error {
+	return e.trySet(colorSchemeAttr, v, "")
+}
+
+// TrySetComment is like SetComment but validates the value against the type of comment attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetComment(v string) error {
+	return g.trySet(commentAttr, v, "")
+}
+
+// TrySetComment is like SetComment but validates the value against the type of comment attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetComment(v string) error {
+	return n.trySet(commentAttr, v, "")
+}
+
+// TrySetComment is like SetComment but validates the value against the type of comment attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetComment(v string) error {
+	return e.trySet(commentAttr, v, "")
+}
+
+// TrySetCompound is like SetCompound but validates the value against the type of compound attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetCompound(v bool) error {
+	return g.trySet(compoundAttr, toBoolString(v), falseStr)
+}
+
+// TrySetConcentrate is like SetConcentrate but validates the value against the type of concentrate attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetConcentrate(v bool) error {
+	return g.trySet(concentrateAttr, toBoolString(v), falseStr)
+}
+
+// TrySetConstraint is like SetConstraint but validates the value against the type of constraint attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetConstraint(v bool) error {
+	return e.trySet(constraintAttr, toBoolString(v), trueStr)
+}
+
+// TrySetDecorate is like SetDecorate but validates the value against the type of decorate attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetDecorate(v bool) error {
+	return e.trySet(decorateAttr, toBoolString(v), falseStr)
+}
+
+// TrySetDefaultDist is like SetDefaultDist but validates the value against the type of defaultdist attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetDefaultDist(v float64) error {
+	return g.trySet(defaultDistAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetDim is like SetDim but validates the value against the type of dim attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetDim(v int) error {
+	return g.trySet(dimAttr, fmt.Sprint(v), "2")
+}
+
+// TrySetDimen is like SetDimen but validates the value against the type of dimen attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetDimen(v int) error {
+	return g.trySet(dimenAttr, fmt.Sprint(v), "2")
+}
+
+// TrySetDir is like SetDir but validates the value against the type of dir attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetDir(v DirType) error {
+	return e.trySet(dirAttr, string(v), string(ForwardDir))
+}
+
+// TrySetDirEdgeConstraints is like SetDirEdgeConstraints but validates the value against the type of diredgeconstraints attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetDirEdgeConstraints(v string) error {
+	return g.trySet(dirEdgeConstraintsAttr, v, falseStr)
+}
+
+// TrySetDistortion is like SetDistortion but validates the value against the type of distortion attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetDistortion(v float64) error {
+	return n.trySet(distortionAttr, fmt.Sprint(v), "0.0")
+}
+
+// TrySetDPI is like SetDPI but validates the value against the type of dpi attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetDPI(v float64) error {
+	return g.trySet(dpiAttr, fmt.Sprint(v), "96.0")
+}
+
+// TrySetEdgeURL is like SetEdgeURL but validates the value against the type of edgeURL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetEdgeURL(v string) error {
+	return e.trySet(edgeURLAttr, v, "")
+}
+
+// TrySetEdgeHref is like SetEdgeHref but validates the value against the type of edgehref attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetEdgeHref(v string) error {
+	return e.trySet(edgeHrefAttr, v, "")
+}
+
+// TrySetEdgeTarget is like SetEdgeTarget but validates the value against the type of edgetarget attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetEdgeTarget(v string) error {
+	return e.trySet(edgeTargetAttr, v, "")
+}
+
+// TrySetEdgeTooltip is like SetEdgeTooltip but validates the value against the type of edgetooltip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetEdgeTooltip(v string) error {
+	return e.trySet(edgeTooltipAttr, v, "")
+}
+
+// TrySetEpsilon is like SetEpsilon but validates the value against the type of epsilon attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetEpsilon(v float64) error {
+	return g.trySet(epsilonAttr, fmt.Sprint(v), ".0001")
+}
+
+// TrySetESep is like SetESep but validates the value against the type of esep attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetESep(v float64) error {
+	return g.trySet(esepAttr, fmt.Sprintf("+%f", v), "+3")
+}
+
+// TrySetFillColor is like SetFillColor but validates the value against the type of fillcolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetFillColor(v string) error {
+	return n.trySet(fillColorAttr, v, "lightgrey")
+}
+
+// TrySetFixedSize is like SetFixedSize but validates the value against the type of fixedsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetFixedSize(v bool) error {
+	return n.trySet(fixedSizeAttr, toBoolString(v), falseStr)
+}
+
+// TrySetFontColor is like SetFontColor but validates the value against the type of fontcolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetFontColor(v string) error {
+	return g.trySet(fontColorAttr, v, "black")
+}
+
+// TrySetFontColor is like SetFontColor but validates the value against the type of fontcolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetFontColor(v string) error {
+	return n.trySet(fontColorAttr, v, "black")
+}
+
+// TrySetFontColor is like SetFontColor but validates the value against the type of fontcolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetFontColor(v string) error {
+	return e.trySet(fontColorAttr, v, "black")
+}
+
+// TrySetFontName is like SetFontName but validates the value against the type of fontname attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetFontName(v string) error {
+	return g.trySet(fontNameAttr, v, "Times-Roman")
+}
+
+// TrySetFontName is like SetFontName but validates the value against the type of fontname attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetFontName(v string) error {
+	return n.trySet(fontNameAttr, v, "Times-Roman")
+}
+
+// TrySetFontName is like SetFontName but validates the value against the type of fontname attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetFontName(v string) error {
+	return e.trySet(fontNameAttr, v, "Times-Roman")
+}
+
+// TrySetFontSize is like SetFontSize but validates the value against the type of fontsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetFontSize(v float64) error {
+	return g.trySet(fontSizeAttr, fmt.Sprint(v), "14.0")
+}
+
+// TrySetFontSize is like SetFontSize but validates the value against the type of fontsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetFontSize(v float64) error {
+	return n.trySet(fontSizeAttr, fmt.Sprint(v), "14.0")
+}
+
+// TrySetFontSize is like SetFontSize but validates the value against the type of fontsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetFontSize(v float64) error {
+	return e.trySet(fontSizeAttr, fmt.Sprint(v), "14.0")
+}
+
+// TrySetForceLabels is like SetForceLabels but validates the value against the type of forcelabels attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetForceLabels(v bool) error {
+	return g.trySet(forceLabelsAttr, toBoolString(v), trueStr)
+}
+
+// TrySetGradientAngle is like SetGradientAngle but validates the value against the type of gradientangle attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetGradientAngle(v int) error {
+	return g.trySet(gradientAngleAttr, fmt.Sprint(v), "")
+}
+
+// TrySetGradientAngle is like SetGradientAngle but validates the value against the type of gradientangle attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetGradientAngle(v int) error {
+	return n.trySet(gradientAngleAttr, fmt.Sprint(v), "")
+}
+
+// TrySetGroup is like SetGroup but validates the value against the type of group attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetGroup(v string) error {
+	return n.trySet(groupAttr, v, "")
+}
+
+// TrySetHeadURL is like SetHeadURL but validates the value against the type of headURL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadURL(v string) error {
+	return e.trySet(headURLAttr, v, "")
+}
+
+// TrySetHeadLabelPoint is like SetHeadLabelPoint but validates the value against the type of head_lp attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadLabelPoint(x, y float64) error {
+	return e.trySet(headLpAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetHeadClip is like SetHeadClip but validates the value against the type of headclip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadClip(v bool) error {
+	return e.trySet(headClipAttr, toBoolString(v), trueStr)
+}
+
+// TrySetHeadHref is like SetHeadHref but validates the value against the type of headhref attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadHref(v string) error {
+	return e.trySet(headHrefAttr, v, "")
+}
+
+// TrySetHeadLabel is like SetHeadLabel but validates the value against the type of headlabel attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadLabel(v string) error {
+	return e.trySet(headLabelAttr, v, "")
+}
+
+// TrySetHeadPort is like SetHeadPort but validates the value against the type of headport attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadPort(v string) error {
+	return e.trySet(headPortAttr, v, "")
+}
+
+// TrySetHeadTarget is like SetHeadTarget but validates the value against the type of headtarget attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadTarget(v string) error {
+	return e.trySet(headTargetAttr, v, "")
+}
+
+// TrySetHeadTooltip is like SetHeadTooltip but validates the value against the type of headtooltip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHeadTooltip(v string) error {
+	return e.trySet(headTooltipAttr, v, "")
+}
+
+// TrySetHeight is like SetHeight but validates the value against the type of height attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetHeight(v float64) error {
+	return n.trySet(heightAttr, fmt.Sprint(v), "0.5")
+}
+
+// TrySetHref is like SetHref but validates the value against the type of href attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetHref(v string) error {
+	return g.trySet(hrefAttr, v, "")
+}
+
+// TrySetHref is like SetHref but validates the value against the type of href attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetHref(v string) error {
+	return n.trySet(hrefAttr, v, "")
+}
+
+// TrySetHref is like SetHref but validates the value against the type of href attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetHref(v string) error {
+	return e.trySet(hrefAttr, v, "")
+}
+
+// TrySetID is like SetID but validates the value against the type of id attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetID(v string) error {
+	return g.trySet(idAttr, v, "")
+}
+
+// TrySetID is like SetID but validates the value against the type of id attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetID(v string) error {
+	return n.trySet(idAttr, v, "")
+}
+
+// TrySetID is like SetID but validates the value against the type of id attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetID(v string) error {
+	return e.trySet(idAttr, v, "")
+}
+
+// TrySetImage is like SetImage but validates the value against the type of image attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetImage(v string) error {
+	return n.trySet(imageAttr, v, "")
+}
+
+// TrySetImagePath is like SetImagePath but validates the value against the type of imagepath attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetImagePath(v string) error {
+	return g.trySet(imagePathAttr, v, "")
+}
+
+// TrySetImagePos is like SetImagePos but validates the value against the type of imagepos attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetImagePos(v ImagePos) error {
+	return n.trySet(imagePosAttr, string(v), string(MiddleCenteredPos))
+}
+
+// TrySetImageScale is like SetImageScale but validates the value against the type of imagescale attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetImageScale(v ImageScale) error {
+	return n.trySet(imageScaleAttr, string(v), string(ImageScaleDefault))
+}
+
+// TrySetInputScale is like SetInputScale but validates the value against the type of inputscale attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetInputScale(v float64) error {
+	return g.trySet(inputScaleAttr, fmt.Sprint(v), "")
+}
+
+// TrySetLabel is like SetLabel but validates the value against the type of label attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLabel(v string) error {
+	return g.trySet(labelAttr, v, "\\G")
+}
+
+// TrySetLabel is like SetLabel but validates the value against the type of label attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetLabel(v string) error {
+	return n.trySet(labelAttr, v, "\\N")
+}
+
+// TrySetLabel is like SetLabel but validates the value against the type of label attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabel(v string) error {
+	return e.trySet(labelAttr, v, "\\E")
+}
+
+// TrySetLabelURL is like SetLabelURL but validates the value against the type of labelURL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelURL(v string) error {
+	return e.trySet(labelURLAttr, v, "")
+}
+
+// TrySetLabelScheme is like SetLabelScheme but validates the value against the type of label_scheme attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLabelScheme(v int) error {
+	return g.trySet(labelSchemeAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetLabelAngle is like SetLabelAngle but validates the value against the type of labelangle attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelAngle(v float64) error {
+	return e.trySet(labelAngleAttr, fmt.Sprint(v), "-25.0")
+}
+
+// TrySetLabelDistance is like SetLabelDistance but validates the value against the type of labeldistance attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelDistance(v float64) error {
+	return e.trySet(labelDistanceAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetLabelFloat is like SetLabelFloat but validates the value against the type of labelfloat attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelFloat(v bool) error {
+	return e.trySet(labelFloatAttr, toBoolString(v), falseStr)
+}
+
+// TrySetLabelFontColor is like SetLabelFontColor but validates the value against the type of labelfontcolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelFontColor(v string) error {
+	return e.trySet(labelFontColorAttr, v, "black")
+}
+
+// TrySetLabelFontSize is like SetLabelFontSize but validates the value against the type of labelfontsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelFontSize(v float64) error {
+	return e.trySet(labelFontSizeAttr, fmt.Sprint(v), "14.0")
+}
+
+// TrySetLabelHref is like SetLabelHref but validates the value against the type of labelhref attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelHref(v string) error {
+	return e.trySet(labelHrefAttr, v, "")
+}
+
+// TrySetLabelJust is like SetLabelJust but validates the value against the type of labeljust attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLabelJust(v JustType) error {
+	return g.trySet(labelJustAttr, string(v), string(CenteredJust))
+}
+
+// TrySetLabelLocation is like SetLabelLocation but validates the value against the type of labelloc attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLabelLocation(v LabelLocation) error {
+	return g.trySet(labelLocAttr, string(v), string(BottomLocation))
+}
+
+// TrySetLabelLocation is like SetLabelLocation but validates the value against the type of labelloc attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetLabelLocation(v LabelLocation) error {
+	return n.trySet(labelLocAttr, string(v), string(CenteredLocation))
+}
+
+// TrySetLabelTarget is like SetLabelTarget but validates the value against the type of labeltarget attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelTarget(v string) error {
+	return e.trySet(labelTargetAttr, v, "")
+}
+
+// TrySetLabelTooltip is like SetLabelTooltip but validates the value against the type of labeltooltip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelTooltip(v string) error {
+	return e.trySet(labelTooltipAttr, v, "")
+}
+
+// TrySetLandscape is like SetLandscape but validates the value against the type of landscape attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLandscape(v bool) error {
+	return g.trySet(landscapeAttr, toBoolString(v), falseStr)
+}
+
+// TrySetLayer is like SetLayer but validates the value against the type of layer attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetLayer(v string) error {
+	return n.trySet(layerAttr, v, "")
+}
+
+// TrySetLayer is like SetLayer but validates the value against the type of layer attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLayer(v string) error {
+	return e.trySet(layerAttr, v, "")
+}
+
+// TrySetLayerListSeparator is like SetLayerListSeparator but validates the value against the type of layerlistsep attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLayerListSeparator(v string) error {
+	return g.trySet(layerListSepAttr, v, ",")
+}
+
+// TrySetLayers is like SetLayers but validates the value against the type of layers attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLayers(v string) error {
+	return g.trySet(layersAttr, v, "")
+}
+
+// TrySetLayerSelect is like SetLayerSelect but validates the value against the type of layerselect attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLayerSelect(v string) error {
+	return g.trySet(layerSelectAttr, v, "")
+}
+
+// TrySetLayerSeparator is like SetLayerSeparator but validates the value against the type of layersep attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLayerSeparator(v string) error {
+	return g.trySet(layerSepAttr, v, ":\\t")
+}
+
+// TrySetLayout is like SetLayout but validates the value against the type of layout attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLayout(v string) error {
+	return g.trySet(layoutAttr, v, "")
+}
+
+// TrySetLen is like SetLen but validates the value against the type of len attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLen(v float64) error {
+	return e.trySet(lenAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetLevels is like SetLevels but validates the value against the type of levels attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLevels(v int) error {
+	return g.trySet(levelsAttr, fmt.Sprint(v), fmt.Sprint(maxInt))
+}
+
+// TrySetLevelsGap is like SetLevelsGap but validates the value against the type of levelsgap attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLevelsGap(v float64) error {
+	return g.trySet(levelsGapAttr, fmt.Sprint(v), "0.0")
+}
+
+// TrySetLogicalHead is like SetLogicalHead but validates the value against the type of lhead attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLogicalHead(v string) error {
+	return e.trySet(lHeadAttr, v, "")
+}
+
+// TrySetLabelHeight is like SetLabelHeight but validates the value against the type of lheight attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelHeight(v float64) error {
+	return e.trySet(lHeightAttr, fmt.Sprint(v), "")
+}
+
+// TrySetLabelPosition is like SetLabelPosition but validates the value against the type of lp attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLabelPosition(x, y float64) error {
+	return g.trySet(lpAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetLabelPosition is like SetLabelPosition but validates the value against the type of lp attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLabelPosition(x, y float64) error {
+	return e.trySet(lpAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetLogicalTail is like SetLogicalTail but validates the value against the type of ltail attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetLogicalTail(v string) error {
+	return e.trySet(lTailAttr, v, "")
+}
+
+// TrySetLabelWidth is like SetLabelWidth but validates the value against the type of lwidth attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLabelWidth(v float64) error {
+	return g.trySet(lWidthAttr, fmt.Sprint(v), "")
+}
+
+// TrySetMargin is like SetMargin but validates the value against the type of margin attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetMargin(v float64) error {
+	return g.trySet(marginAttr, fmt.Sprint(v), "")
+}
+
+// TrySetMargin is like SetMargin but validates the value against the type of margin attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetMargin(v float64) error {
+	return n.trySet(marginAttr, fmt.Sprint(v), "")
+}
+
+// TrySetMaxIterator is like SetMaxIterator but validates the value against the type of maxiter attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetMaxIterator(v int) error {
+	return g.trySet(maxIterAttr, fmt.Sprint(v), "200")
+}
+
+// TrySetMCLimit is like SetMCLimit but validates the value against the type of mclimit attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetMCLimit(v float64) error {
+	return g.trySet(mcLimitAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetMinDist is like SetMinDist but validates the value against the type of mindist attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetMinDist(v float64) error {
+	return g.trySet(minDistAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetMinLen is like SetMinLen but validates the value against the type of minlen attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetMinLen(v int) error {
+	return e.trySet(minLenAttr, fmt.Sprint(v), "1")
+}
+
+// TrySetMode is like SetMode but validates the value against the type of mode attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetMode(v ModeType) error {
+	return g.trySet(modeAttr, string(v), string(MajorMode))
+}
+
+// TrySetModel is like SetModel but validates the value against the type of model attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetModel(v ModelType) error {
+	return g.trySet(modelAttr, string(v), string(ShortPathModel))
+}
+
+// TrySetMosek is like SetMosek but validates the value against the type of mosek attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetMosek(v bool) error {
+	return g.trySet(mosekAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNewRank is like SetNewRank but validates the value against the type of newrank attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNewRank(v bool) error {
+	return g.trySet(newRankAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNodeSeparator is like SetNodeSeparator but validates the value against the type of nodesep attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNodeSeparator(v float64) error {
+	return g.trySet(nodeSepAttr, fmt.Sprint(v), "0.25")
+}
+
+// TrySetNoJustify is like SetNoJustify but validates the value against the type of nojustify attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNoJustify(v bool) error {
+	return g.trySet(noJustifyAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNoJustify is like SetNoJustify but validates the value against the type of nojustify attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetNoJustify(v bool) error {
+	return n.trySet(noJustifyAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNoJustify is like SetNoJustify but validates the value against the type of nojustify attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetNoJustify(v bool) error {
+	return e.trySet(noJustifyAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNormalize is like SetNormalize but validates the value against the type of normalize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNormalize(v bool) error {
+	return g.trySet(normalizeAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNoTranslate is like SetNoTranslate but validates the value against the type of notranslate attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNoTranslate(v bool) error {
+	return g.trySet(noTranslateAttr, toBoolString(v), falseStr)
+}
+
+// TrySetNsLimit is like SetNsLimit but validates the value against the type of nslimit attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNsLimit(v float64) error {
+	return g.trySet(nsLimitAttr, fmt.Sprint(v), "")
+}
+
+// TrySetNsLimit1 is like SetNsLimit1 but validates the value against the type of nslimit1 attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetNsLimit1(v float64) error {
+	return g.trySet(nsLimit1Attr, fmt.Sprint(v), "")
+}
+
+// TrySetOrdering is like SetOrdering but validates the value against the type of ordering attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOrdering(v OrderingType) error {
+	return g.trySet(orderingAttr, string(v), "")
+}
+
+// TrySetOrdering is like SetOrdering but validates the value against the type of ordering attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetOrdering(v OrderingType) error {
+	return n.trySet(orderingAttr, string(v), "")
+}
+
+// TrySetOrientation is like SetOrientation but validates the value against the type of orientation attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOrientation(v string) error {
+	return g.trySet(orientationAttr, v, "")
+}
+
+// TrySetOrientation is like SetOrientation but validates the value against the type of orientation attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetOrientation(v float64) error {
+	return n.trySet(orientationAttr, fmt.Sprint(v), "0.0")
+}
+
+// TrySetOutputOrder is like SetOutputOrder but validates the value against the type of outputorder attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOutputOrder(v OutputMode) error {
+	return g.trySet(outputOrderAttr, string(v), string(BreadthFirst))
+}
+
+// TrySetOverlap is like SetOverlap but validates the value against the type of overlap attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOverlap(v bool) error {
+	return g.trySet(overlapAttr, toBoolString(v), trueStr)
+}
+
+// TrySetOverlapScaling is like SetOverlapScaling but validates the value against the type of overlap_scaling attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOverlapScaling(v float64) error {
+	return g.trySet(overlapScalingAttr, fmt.Sprint(v), "-4")
+}
+
+// TrySetOverlapShrink is like SetOverlapShrink but validates the value against the type of overlap_shrink attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOverlapShrink(v bool) error {
+	return g.trySet(overlapShrinkAttr, toBoolString(v), trueStr)
+}
+
+// TrySetPack is like SetPack but validates the value against the type of pack attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetPack(v bool) error {
+	return g.trySet(packAttr, toBoolString(v), falseStr)
+}
+
+// TrySetPackMode is like SetPackMode but validates the value against the type of packmode attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetPackMode(v PackMode) error {
+	return g.trySet(packModeAttr, string(v), string(NodePack))
+}
+
+// TrySetPad is like SetPad but validates the value against the type of pad attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetPad(v float64) error {
+	return g.trySet(padAttr, fmt.Sprint(v), "0.0555")
+}
+
+// TrySetPage is like SetPage but validates the value against the type of page attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetPage(v float64) error {
+	return g.trySet(pageAttr, fmt.Sprint(v), "")
+}
+
+// TrySetPageDir is like SetPageDir but validates the value against the type of pagedir attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetPageDir(v PageDir) error {
+	return g.trySet(pageDirAttr, string(v), string(BLDir))
+}
+
+// TrySetPenWidth is like SetPenWidth but validates the value against the type of penwidth attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetPenWidth(v float64) error {
+	return n.trySet(penWidthAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetPenWidth is like SetPenWidth but validates the value against the type of penwidth attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetPenWidth(v float64) error {
+	return e.trySet(penWidthAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetPeripheries is like SetPeripheries but validates the value against the type of peripheries attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetPeripheries(v int) error {
+	return n.trySet(peripheriesAttr, fmt.Sprint(v), "1")
+}
+
+// TrySetPin is like SetPin but validates the value against the type of pin attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetPin(v bool) error {
+	return n.trySet(pinAttr, toBoolString(v), falseStr)
+}
+
+// TrySetPos is like SetPos but validates the value against the type of pos attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetPos(x, y float64) error {
+	return n.trySet(posAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetPos is like SetPos but validates the value against the type of pos attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetPos(x, y float64) error {
+	return e.trySet(posAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetQuadTree is like SetQuadTree but validates the value against the type of quadtree attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetQuadTree(v QuadType) error {
+	return g.trySet(quadTreeAttr, string(v), string(NormalQuad))
+}
+
+// TrySetQuantum is like SetQuantum but validates the value against the type of quantum attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetQuantum(v float64) error {
+	return g.trySet(quantumAttr, fmt.Sprint(v), "0.0")
+}
+
+// TrySetRankDir is like SetRankDir but validates the value against the type of rankdir attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRankDir(v RankDir) error {
+	return g.trySet(rankDirAttr, string(v), string(TBRank))
+}
+
+// TrySetRankSeparator is like SetRankSeparator but validates the value against the type of ranksep attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRankSeparator(v float64) error {
+	return g.trySet(rankSepAttr, fmt.Sprint(v), "0.5")
+}
+
+// TrySetRatio is like SetRatio but validates the value against the type of ratio attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRatio(v RatioType) error {
+	return g.trySet(ratioAttr, string(v), "")
+}
+
+// TrySetRects is like SetRects but validates the value against the type of rects attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetRects(llx, lly, urx, ury float64) error {
+	return n.trySet(rectsAttr, fmt.Sprintf("%f,%f,%f,%f", llx, lly, urx, ury), "")
+}
+
+// TrySetRegular is like SetRegular but validates the value against the type of regular attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetRegular(v bool) error {
+	return n.trySet(regularAttr, toBoolString(v), falseStr)
+}
+
+// TrySetReminCross is like SetReminCross but validates the value against the type of remincross attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetReminCross(v bool) error {
+	return g.trySet(remincrossAttr, toBoolString(v), trueStr)
+}
+
+// TrySetRepulsiveForce is like SetRepulsiveForce but validates the value against the type of repulsiveforce attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRepulsiveForce(v float64) error {
+	return g.trySet(repulsiveforceAttr, fmt.Sprint(v), "1.0")
+}
+
+// TrySetResolution is like SetResolution but validates the value against the type of resolution attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetResolution(v float64) error {
+	return g.trySet(resolutionAttr, fmt.Sprint(v), "96.0")
+}
+
+// TrySetRoot is like SetRoot but validates the value against the type of root attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRoot(v bool) error {
+	return g.trySet(rootAttr, toBoolString(v), falseStr)
+}
+
+// TrySetRoot is like SetRoot but validates the value against the type of root attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetRoot(v bool) error {
+	return n.trySet(rootAttr, toBoolString(v), falseStr)
+}
+
+// TrySetRotate is like SetRotate but validates the value against the type of rotate attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRotate(v int) error {
+	return g.trySet(rotateAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetRotation is like SetRotation but validates the value against the type of rotation attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetRotation(v float64) error {
+	return g.trySet(rotationAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetSameHead is like SetSameHead but validates the value against the type of samehead attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetSameHead(v string) error {
+	return e.trySet(sameHeadAttr, v, "")
+}
+
+// TrySetSameTail is like SetSameTail but validates the value against the type of sametail attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetSameTail(v string) error {
+	return e.trySet(sameTailAttr, v, "")
+}
+
+// TrySetSamplePoints is like SetSamplePoints but validates the value against the type of samplepoints attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetSamplePoints(v int) error {
+	return n.trySet(samplePointsAttr, fmt.Sprint(v), "8")
+}
+
+// TrySetScale is like SetScale but validates the value against the type of scale attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetScale(x, y float64) error {
+	return g.trySet(scaleAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetSearchSize is like SetSearchSize but validates the value against the type of searchsize attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetSearchSize(v int) error {
+	return g.trySet(searchSizeAttr, fmt.Sprint(v), "30")
+}
+
+// TrySetSeparator is like SetSeparator but validates the value against the type of sep attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetSeparator(v string) error {
+	return g.trySet(sepAttr, v, "+4")
+}
+
+// TrySetShape is like SetShape but validates the value against the type of shape attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetShape(v Shape) error {
+	return n.trySet(shapeAttr, string(v), string(EllipseShape))
+}
+
+// TrySetShapeFile is like SetShapeFile but validates the value against the type of shapefile attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetShapeFile(v string) error {
+	return n.trySet(shapeFileAttr, v, "")
+}
+
+// TrySetShowBoxes is like SetShowBoxes but validates the value against the type of showboxes attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetShowBoxes(v int) error {
+	return g.trySet(showBoxesAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetShowBoxes is like SetShowBoxes but validates the value against the type of showboxes attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetShowBoxes(v int) error {
+	return n.trySet(showBoxesAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetShowBoxes is like SetShowBoxes but validates the value against the type of showboxes attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetShowBoxes(v int) error {
+	return e.trySet(showBoxesAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetSides is like SetSides but validates the value against the type of sides attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetSides(v int) error {
+	return n.trySet(sidesAttr, fmt.Sprint(v), "4")
+}
+
+// TrySetSize is like SetSize but validates the value against the type of size attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetSize(x, y float64) error {
+	return g.trySet(sizeAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetSkew is like SetSkew but validates the value against the type of skew attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetSkew(v float64) error {
+	return n.trySet(skewAttr, fmt.Sprint(v), "0.0")
+}
+
+// TrySetSmoothing is like SetSmoothing but validates the value against the type of smoothing attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetSmoothing(v SmoothType) error {
+	return g.trySet(smoothingAttr, string(v), string(NoneSmooth))
+}
+
+// TrySetSortv is like SetSortv but validates the value against the type of sortv attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetSortv(v int) error {
+	return g.trySet(sortvAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetSortv is like SetSortv but validates the value against the type of sortv attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetSortv(v int) error {
+	return n.trySet(sortvAttr, fmt.Sprint(v), "0")
+}
+
+// TrySetSplines is like SetSplines but validates the value against the type of splines attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetSplines(v string) error {
+	return g.trySet(splinesAttr, v, "")
+}
+
+// TrySetStart is like SetStart but validates the value against the type of start attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetStart(v StartType) error {
+	return g.trySet(startAttr, string(v), "")
+}
+
+// TrySetStyle is like SetStyle but validates the value against the type of style attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetStyle(v GraphStyle) error {
+	return g.trySet(styleAttr, string(v), "")
+}
+
+// TrySetStyle is like SetStyle but validates the value against the type of style attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetStyle(v NodeStyle) error {
+	return n.trySet(styleAttr, string(v), "")
+}
+
+// TrySetStyle is like SetStyle but validates the value against the type of style attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetStyle(v EdgeStyle) error {
+	return e.trySet(styleAttr, string(v), "")
+}
+
+// TrySetStyleSheet is like SetStyleSheet but validates the value against the type of stylesheet attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetStyleSheet(v string) error {
+	return g.trySet(stylesheetAttr, v, "")
+}
+
+// TrySetTailURL is like SetTailURL but validates the value against the type of tailURL attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailURL(v string) error {
+	return e.trySet(tailURLAttr, v, "")
+}
+
+// TrySetTailLabelPoint is like SetTailLabelPoint but validates the value against the type of tail_lp attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailLabelPoint(x, y float64) error {
+	return e.trySet(tailLpAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetTailClip is like SetTailClip but validates the value against the type of tailclip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailClip(v bool) error {
+	return e.trySet(tailClipAttr, toBoolString(v), trueStr)
+}
+
+// TrySetTailHref is like SetTailHref but validates the value against the type of tailhref attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailHref(v string) error {
+	return e.trySet(tailHrefAttr, v, "")
+}
+
+// TrySetTailLabel is like SetTailLabel but validates the value against the type of taillabel attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailLabel(v string) error {
+	return e.trySet(tailLabelAttr, v, "")
+}
+
+// TrySetTailPort is like SetTailPort but validates the value against the type of tailport attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailPort(v string) error {
+	return e.trySet(tailPortAttr, v, "center")
+}
+
+// TrySetTailTarget is like SetTailTarget but validates the value against the type of tailtarget attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailTarget(v string) error {
+	return e.trySet(tailTargetAttr, v, "")
+}
+
+// TrySetTailTooltip is like SetTailTooltip but validates the value against the type of tailtooltip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTailTooltip(v string) error {
+	return e.trySet(tailTooltipAttr, v, "")
+}
+
+// TrySetTarget is like SetTarget but validates the value against the type of target attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetTarget(v string) error {
+	return g.trySet(targetAttr, v, "")
+}
+
+// TrySetTarget is like SetTarget but validates the value against the type of target attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetTarget(v string) error {
+	return n.trySet(targetAttr, v, "")
+}
+
+// TrySetTarget is like SetTarget but validates the value against the type of target attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTarget(v string) error {
+	return e.trySet(targetAttr, v, "")
+}
+
+// TrySetTooltip is like SetTooltip but validates the value against the type of tooltip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetTooltip(v string) error {
+	return n.trySet(tooltipAttr, v, "")
+}
+
+// TrySetTooltip is like SetTooltip but validates the value against the type of tooltip attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetTooltip(v string) error {
+	return e.trySet(tooltipAttr, v, "")
+}
+
+// TrySetTrueColor is like SetTrueColor but validates the value against the type of truecolor attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetTrueColor(v bool) error {
+	return g.trySet(trueColorAttr, toBoolString(v), "")
+}
+
+// TrySetVertices is like SetVertices but validates the value against the type of vertices attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetVertices(v string) error {
+	return n.trySet(verticesAttr, v, "")
+}
+
+// TrySetViewport is like SetViewport but validates the value against the type of viewport attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetViewport(v string) error {
+	return g.trySet(viewportAttr, v, "")
+}
+
+// TrySetVoroMargin is like SetVoroMargin but validates the value against the type of voro_margin attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetVoroMargin(v float64) error {
+	return g.trySet(voroMarginAttr, fmt.Sprint(v), "0.05")
+}
+
+// TrySetWeight is like SetWeight but validates the value against the type of weight attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetWeight(v float64) error {
+	return e.trySet(weightAttr, fmt.Sprint(v), "1")
+}
+
+// TrySetWidth is like SetWidth but validates the value against the type of width attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetWidth(v float64) error {
+	return n.trySet(widthAttr, fmt.Sprint(v), "0.75")
+}
+
+// TrySetXDotVersion is like SetXDotVersion but validates the value against the type of xdotversion attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetXDotVersion(v string) error {
+	return g.trySet(xdotVersionAttr, v, "")
+}
+
+// TrySetXLabel is like SetXLabel but validates the value against the type of xlabel attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetXLabel(v string) error {
+	return n.trySet(xlabelAttr, v, "")
+}
+
+// TrySetXLabel is like SetXLabel but validates the value against the type of xlabel attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetXLabel(v string) error {
+	return e.trySet(xlabelAttr, v, "")
+}
+
+// TrySetXLabelPosition is like SetXLabelPosition but validates the value against the type of xlp attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetXLabelPosition(x, y float64) error {
+	return n.trySet(xlpAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetXLabelPosition is like SetXLabelPosition but validates the value against the type of xlp attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetXLabelPosition(x, y float64) error {
+	return e.trySet(xlpAttr, fmt.Sprintf("%f,%f", x, y), "")
+}
+
+// TrySetZ is like SetZ but validates the value against the type of z attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetZ(v float64) error {
+	return n.trySet(zAttr, fmt.Sprint(v), "0.0")
+}
+
+// TrySetTBBalance is like SetTBBalance but validates the value against the type of TBbalance attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetTBBalance(v string) error {
+	return g.trySet(tbBalanceAttr, v, "")
+}
+
+// TrySetBeautify is like SetBeautify but validates the value against the type of beautify attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetBeautify(v bool) error {
+	return g.trySet(beautifyAttr, toBoolString(v), "false")
+}
+
+// TrySetClass is like SetClass but validates the value against the type of class attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetClass(v string) error {
+	return g.trySet(classAttr, v, "")
+}
+
+// TrySetClass is like SetClass but validates the value against the type of class attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (n *Node) TrySetClass(v string) error {
+	return n.trySet(classAttr, v, "")
+}
+
+// TrySetClass is like SetClass but validates the value against the type of class attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (e *Edge) TrySetClass(v string) error {
+	return e.trySet(classAttr, v, "")
+}
+
+// TrySetCluster is like SetCluster but validates the value against the type of cluster attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetCluster(v bool) error {
+	return g.trySet(clusterAttr, toBoolString(v), "false")
+}
+
+// TrySetLineLength is like SetLineLength but validates the value against the type of linelength attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetLineLength(v int) error {
+	return g.trySet(lineLengthAttr, fmt.Sprint(v), "128")
+}
+
+// TrySetOneBlock is like SetOneBlock but validates the value against the type of oneblock attribute.
+// If the value is invalid, it is not set and an *AttributeError is returned.
+func (g *Graph) TrySetOneBlock(v bool) error {
+	return g.trySet(oneBlockAttr, toBoolString(v), "false")
+}
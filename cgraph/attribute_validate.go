@@ -0,0 +1,370 @@
+package cgraph
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AttributeError is returned by TrySet* methods when the value does not conform to the type of the attribute.
+type AttributeError struct {
+	// Name is the name of the attribute. e.g. "color".
+	Name string
+	// Value is the rejected value.
+	Value string
+	// Type is the name of the attribute type described in https://graphviz.org/docs/attr-types/ . e.g. "arrowType".
+	Type string
+	// Err describes why the value is rejected.
+	Err error
+}
+
+func (e *AttributeError) Error() string {
+	return fmt.Sprintf("cgraph: invalid %s value %q for %s attribute: %v", e.Type, e.Value, e.Name, e.Err)
+}
+
+func (e *AttributeError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	ErrUnknownColor       = errors.New("unknown color")
+	ErrUnknownColorScheme = errors.New("unknown color scheme")
+	ErrInvalidEscape      = errors.New("invalid escape sequence")
+	ErrUnknownValue       = errors.New("unknown value")
+	ErrOutOfRange         = errors.New("out of range")
+)
+
+func (g *Graph) trySet(name attribute, value, def string) error {
+	if err := validateAttribute(name, g.usage(), value, g.GetStr(string(colorSchemeAttr))); err != nil {
+		return err
+	}
+	return g.SafeSet(string(name), value, def)
+}
+
+func (n *Node) trySet(name attribute, value, def string) error {
+	if err := validateAttribute(name, nodeUsage, value, n.GetStr(string(colorSchemeAttr))); err != nil {
+		return err
+	}
+	return n.SafeSet(string(name), value, def)
+}
+
+func (e *Edge) trySet(name attribute, value, def string) error {
+	if err := validateAttribute(name, edgeUsage, value, e.GetStr(string(colorSchemeAttr))); err != nil {
+		return err
+	}
+	return e.SafeSet(string(name), value, def)
+}
+
+// usage returns whether g is the root graph, a cluster or a plain subgraph.
+func (g *Graph) usage() attributeUsage {
+	if g.Parent() == nil {
+		return graphUsage
+	}
+	if name, _ := g.Name(); strings.HasPrefix(name, "cluster") {
+		return clusterUsage
+	}
+	return subgraphUsage
+}
+
+// lookupAttributeSchema returns the schema of the attribute for the usage.
+// If the attribute does not apply to the usage, the first schema of the attribute is returned.
+func lookupAttributeSchema(name attribute, usage attributeUsage) *attributeSchema {
+	var found *attributeSchema
+	for _, s := range attributeSchemas {
+		if s.name != name {
+			continue
+		}
+		if s.usage&usage != 0 {
+			return s
+		}
+		if found == nil {
+			found = s
+		}
+	}
+	return found
+}
+
+// validateAttribute reports whether value conforms to the type of the attribute.
+// An empty value is always valid because it resets the attribute to the default.
+// scheme is the color scheme of the object and is used to resolve color names.
+func validateAttribute(name attribute, usage attributeUsage, value, scheme string) error {
+	s := lookupAttributeSchema(name, usage)
+	if s == nil || value == "" {
+		return nil
+	}
+	var err error
+	if name == colorSchemeAttr {
+		err = validateColorScheme(value)
+	} else if err = validateValue(s.typ, value, scheme); err == nil {
+		err = s.validateMinimum(value)
+	}
+	if err != nil {
+		return &AttributeError{Name: string(name), Value: value, Type: string(s.typ), Err: err}
+	}
+	return nil
+}
+
+func validateValue(typ valueType, v, scheme string) error {
+	switch typ {
+	case stringValue:
+		return nil
+	case escStringValue:
+		return validateEscString(v)
+	case lblStringValue:
+		if strings.HasPrefix(v, "<") && strings.HasSuffix(v, ">") {
+			return nil
+		}
+		return validateEscString(v)
+	case boolValue:
+		_, err := parseBool(v)
+		return err
+	case intValue:
+		_, err := parseInt(v)
+		return err
+	case doubleValue:
+		_, err := parseDouble(v)
+		return err
+	case pointValue:
+		_, err := parsePoint(v)
+		return err
+	case pointListValue:
+		_, err := parsePointList(v)
+		return err
+	case rectValue:
+		_, err := parseRect(v)
+		return err
+	case splineTypeValue:
+		_, err := parseSplines(v)
+		return err
+	case colorValue:
+		return validateColor(v, scheme)
+	case colorListValue:
+		return validateColorList(v, scheme)
+	case arrowTypeValue:
+		return validateArrowType(v)
+	case imageScaleValue, quadTypeValue:
+		if _, err := parseBool(v); err == nil {
+			return nil
+		}
+	case packModeValue:
+		return validatePackMode(v)
+	case ratioValue:
+		if _, err := parseDouble(v); err == nil {
+			return nil
+		}
+	case startTypeValue:
+		return validateStartType(v)
+	case graphStyleValue, nodeStyleValue, edgeStyleValue:
+		return validateStyle(typ, v)
+	}
+	return validateEnum(typ, v)
+}
+
+// enumValues is the set of the values Graphviz accepts for each enumerated type.
+// It can be wider than the constants defined in this package.
+var enumValues = map[valueType][]string{
+	clusterModeValue: {"local", "global", "none"},
+	dirTypeValue:     {"forward", "back", "both", "none"},
+	imagePosValue:    {"tl", "tc", "tr", "ml", "mc", "mr", "bl", "bc", "br"},
+	imageScaleValue:  {"width", "height", "both"},
+	justValue:        {"l", "r", "c"},
+	labelLocValue:    {"t", "c", "b"},
+	modeValue:        {"major", "KK", "sgd", "hier", "ipsep", "spring", "maxent"},
+	modelValue:       {"circuit", "subset", "shortpath", "mds"},
+	orderingValue:    {"in", "out"},
+	outputModeValue:  {"breadthfirst", "nodesfirst", "edgesfirst"},
+	packModeValue:    {"node", "clust", "graph"},
+	pageDirValue:     {"BL", "BR", "TL", "TR", "RB", "RT", "LB", "LT"},
+	quadTypeValue:    {"normal", "fast", "none"},
+	rankDirValue:     {"TB", "LR", "BT", "RL"},
+	ratioValue:       {"fill", "compress", "expand", "auto"},
+	shapeValue: {
+		"box", "polygon", "ellipse", "oval", "circle", "point", "egg", "triangle", "plaintext", "plain",
+		"diamond", "trapezium", "parallelogram", "house", "pentagon", "hexagon", "septagon", "octagon",
+		"doublecircle", "doubleoctagon", "tripleoctagon", "invtriangle", "invtrapezium", "invhouse",
+		"Mdiamond", "Msquare", "Mcircle", "rect", "rectangle", "square", "star", "none", "underline",
+		"cylinder", "note", "tab", "folder", "box3d", "component", "promoter", "cds", "terminator", "utr",
+		"primersite", "restrictionsite", "fivepoverhang", "threepoverhang", "noverhang", "assembly",
+		"signature", "insulator", "ribosite", "rnastab", "proteasesite", "proteinstab", "rpromoter",
+		"rarrow", "larrow", "lpromoter", "record", "Mrecord", "epsf", "custom",
+	},
+	smoothTypeValue: {"none", "avg_dist", "graph_dist", "power_dist", "rng", "spring", "triangle"},
+	startTypeValue:  {"regular", "self", "random"},
+	graphStyleValue: {"solid", "dashed", "dotted", "bold", "invis", "filled", "striped", "rounded", "radial"},
+	nodeStyleValue: {
+		"solid", "dashed", "dotted", "bold", "invis", "filled", "striped", "wedged", "diagonals", "rounded", "radial",
+	},
+	edgeStyleValue: {"solid", "dashed", "dotted", "bold", "invis", "tapered"},
+	tbBalanceValue: {"min", "max"},
+}
+
+func validateEnum(typ valueType, v string) error {
+	values, exists := enumValues[typ]
+	if !exists {
+		return nil
+	}
+	for _, value := range values {
+		// Graphviz compares shape names case-insensitively.
+		if value == v || (typ == shapeValue && strings.EqualFold(value, v)) {
+			return nil
+		}
+	}
+	return ErrUnknownValue
+}
+
+// validateEscString rejects a trailing backslash, which escapes the closing quote when the graph is written as DOT.
+// Graphviz interprets the backslash sequences described in https://graphviz.org/docs/attr-types/escString/
+// and passes through the others, so they are accepted.
+func validateEscString(v string) error {
+	for i := 0; i < len(v); i++ {
+		if v[i] != '\\' {
+			continue
+		}
+		i++
+		if i == len(v) {
+			return fmt.Errorf("%w: trailing backslash", ErrInvalidEscape)
+		}
+	}
+	return nil
+}
+
+// validateStyle validates a comma-separated style list. The legacy setlinewidth(N) form is accepted as well.
+func validateStyle(typ valueType, v string) error {
+	for _, s := range strings.Split(v, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		if arg, found := strings.CutPrefix(s, "setlinewidth("); found && strings.HasSuffix(arg, ")") {
+			if _, err := parseDouble(strings.TrimSuffix(arg, ")")); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := validateEnum(typ, s); err != nil {
+			return fmt.Errorf("%w %q", err, s)
+		}
+	}
+	return nil
+}
+
+// validatePackMode validates "node", "clust", "graph" or "array[_flags][N]".
+func validatePackMode(v string) error {
+	rest, found := strings.CutPrefix(v, "array")
+	if !found {
+		return validateEnum(packModeValue, v)
+	}
+	if flags, found := strings.CutPrefix(rest, "_"); found {
+		i := 0
+		for i < len(flags) && strings.ContainsRune("ctblru", rune(flags[i])) {
+			i++
+		}
+		if i == 0 {
+			return fmt.Errorf("%w: missing array flags", ErrUnknownValue)
+		}
+		rest = flags[i:]
+	}
+	if rest == "" {
+		return nil
+	}
+	if _, err := strconv.Atoi(rest); err != nil {
+		return fmt.Errorf("%w: invalid array size %q", ErrUnknownValue, rest)
+	}
+	return nil
+}
+
+// validateStartType validates "regular", "self" or "random" optionally followed by a seed, or a seed alone.
+func validateStartType(v string) error {
+	seed := v
+	for _, s := range enumValues[startTypeValue] {
+		if rest, found := strings.CutPrefix(v, s); found {
+			seed = rest
+			break
+		}
+	}
+	if seed == "" {
+		return nil
+	}
+	if _, err := strconv.Atoi(seed); err != nil {
+		return fmt.Errorf("%w: invalid seed %q", ErrUnknownValue, seed)
+	}
+	return nil
+}
+
+var (
+	arrowPrimitives = []string{"box", "crow", "curve", "icurve", "diamond", "dot", "inv", "none", "normal", "tee", "vee"}
+	// arrowSynonyms are the legacy names kept by Graphviz.
+	arrowSynonyms = []string{"ediamond", "open", "halfopen", "empty", "invempty"}
+)
+
+// maxArrowShapes is the maximum number of shapes an arrowType value can be composed of.
+const maxArrowShapes = 4
+
+// validateArrowType validates the grammar of https://graphviz.org/docs/attr-types/arrowType/ .
+// A value is a sequence of up to 4 shapes, each of which is a primitive with the optional modifiers "o" and "l" or "r".
+func validateArrowType(v string) error {
+	rest := v
+	for n := 0; rest != ""; n++ {
+		if n == maxArrowShapes {
+			return fmt.Errorf("%w: more than %d arrow shapes", ErrUnknownValue, maxArrowShapes)
+		}
+		shape, ok := cutArrowShape(rest)
+		if !ok {
+			return fmt.Errorf("%w: unknown arrow shape %q", ErrUnknownValue, rest)
+		}
+		rest = rest[len(shape):]
+	}
+	return nil
+}
+
+func cutArrowShape(v string) (string, bool) {
+	for _, s := range arrowSynonyms {
+		if strings.HasPrefix(v, s) {
+			return s, true
+		}
+	}
+	var modifiers string
+	if strings.HasPrefix(v, "o") {
+		modifiers += "o"
+	}
+	if m := strings.TrimPrefix(v, modifiers); strings.HasPrefix(m, "l") || strings.HasPrefix(m, "r") {
+		modifiers += m[:1]
+	}
+	for _, p := range arrowPrimitives {
+		if strings.HasPrefix(v[len(modifiers):], p) {
+			return modifiers + p, true
+		}
+	}
+	return "", false
+}
+
+func validateColorList(v, scheme string) error {
+	colors, err := parseColorList(v, scheme)
+	if err != nil {
+		return err
+	}
+	var total float64
+	for _, c := range colors {
+		if c.Weight < 0 || c.Weight > 1 {
+			return fmt.Errorf("color weight %g is out of range [0, 1]", c.Weight)
+		}
+		total += c.Weight
+	}
+	if total > 1 {
+		return fmt.Errorf("sum of color weights %g is greater than 1", total)
+	}
+	return nil
+}
+
+func validateColor(v, scheme string) error {
+	_, err := parseColor(v, scheme)
+	return err
+}
+
+func validateColorScheme(v string) error {
+	if _, exists := colorSchemeSize(v); exists {
+		return nil
+	}
+	return ErrUnknownColorScheme
+}
@@ -0,0 +1,185 @@
+package cgraph
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Point is a value of point type. e.g. "1,2" or "1,2!".
+// A single value such as "1" ( accepted by size, page, margin and so on ) sets both X and Y.
+type Point struct {
+	X float64
+	Y float64
+	// Fixed reports whether the value ends with "!".
+	Fixed bool
+}
+
+// Rect is a value of rect type. e.g. "0,0,100,200".
+type Rect struct {
+	LLX float64
+	LLY float64
+	URX float64
+	URY float64
+}
+
+// Spline is a value of splineType. e.g. "e,10,20 0,0 1,1 2,2 3,3".
+type Spline struct {
+	// Start is the point the arrowhead at the tail points to, if any.
+	Start *Point
+	// End is the point the arrowhead at the head points to, if any.
+	End *Point
+	// Points are the control points of the B-spline.
+	Points []Point
+}
+
+// attributeValue returns the parsed value of the attribute.
+// If the attribute is empty or cannot be parsed, the parsed default value is returned with false.
+func attributeValue[T any](getStr func(string) string, name attribute, def string, parse func(string) (T, error)) (T, bool) {
+	if v := getStr(string(name)); v != "" {
+		if ret, err := parse(v); err == nil {
+			return ret, true
+		}
+	}
+	ret, _ := parse(def)
+	return ret, false
+}
+
+// withColorScheme binds the colorscheme attribute of the object to parse,
+// so that a color name such as "3" is resolved with the scheme of the object.
+func withColorScheme[T any](getStr func(string) string, parse func(string, string) (T, error)) func(string) (T, error) {
+	scheme := getStr(string(colorSchemeAttr))
+	return func(v string) (T, error) {
+		return parse(v, scheme)
+	}
+}
+
+func parseString(v string) (string, error) {
+	return v, nil
+}
+
+func parseEnum[T ~string](v string) (T, error) {
+	return T(v), nil
+}
+
+func parseEnumList[T ~string](v string) ([]T, error) {
+	var ret []T
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			ret = append(ret, T(s))
+		}
+	}
+	return ret, nil
+}
+
+func parseBool(v string) (bool, error) {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "true", "yes":
+		return true, nil
+	case "false", "no", "":
+		return false, nil
+	}
+	i, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil {
+		return false, fmt.Errorf("invalid bool value %q", v)
+	}
+	return i != 0, nil
+}
+
+func parseInt(v string) (int, error) {
+	return strconv.Atoi(strings.TrimSpace(v))
+}
+
+func parseDouble(v string) (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(v), 64)
+}
+
+func parsePoint(v string) (Point, error) {
+	v = strings.TrimSpace(v)
+	var p Point
+	if strings.HasSuffix(v, "!") {
+		p.Fixed = true
+		v = strings.TrimSuffix(v, "!")
+	}
+	// the prefix "+" of addPoint type is ignored.
+	v = strings.TrimPrefix(v, "+")
+	values, err := parseDoubles(v, ",", 1, 3)
+	if err != nil {
+		return Point{}, fmt.Errorf("invalid point value %q", v)
+	}
+	p.X, p.Y = values[0], values[0]
+	if len(values) > 1 {
+		p.Y = values[1]
+	}
+	return p, nil
+}
+
+func parsePointList(v string) ([]Point, error) {
+	var ret []Point
+	for _, s := range strings.Fields(v) {
+		p, err := parsePoint(s)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, p)
+	}
+	return ret, nil
+}
+
+func parseRect(v string) (Rect, error) {
+	values, err := parseDoubles(v, ",", 4, 4)
+	if err != nil {
+		return Rect{}, fmt.Errorf("invalid rect value %q", v)
+	}
+	return Rect{LLX: values[0], LLY: values[1], URX: values[2], URY: values[3]}, nil
+}
+
+func parseSplines(v string) ([]Spline, error) {
+	var ret []Spline
+	for _, s := range strings.Split(v, ";") {
+		var spline Spline
+		for _, field := range strings.Fields(s) {
+			switch {
+			case strings.HasPrefix(field, "s,"):
+				p, err := parsePoint(field[2:])
+				if err != nil {
+					return nil, err
+				}
+				spline.Start = &p
+			case strings.HasPrefix(field, "e,"):
+				p, err := parsePoint(field[2:])
+				if err != nil {
+					return nil, err
+				}
+				spline.End = &p
+			default:
+				p, err := parsePoint(field)
+				if err != nil {
+					return nil, err
+				}
+				spline.Points = append(spline.Points, p)
+			}
+		}
+		if len(spline.Points) == 0 {
+			continue
+		}
+		ret = append(ret, spline)
+	}
+	return ret, nil
+}
+
+func parseDoubles(v, sep string, minNum, maxNum int) ([]float64, error) {
+	fields := strings.Split(v, sep)
+	if len(fields) < minNum || len(fields) > maxNum {
+		return nil, fmt.Errorf("unexpected number of values %q", v)
+	}
+	ret := make([]float64, 0, len(fields))
+	for _, f := range fields {
+		d, err := parseDouble(f)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, d)
+	}
+	return ret, nil
+}
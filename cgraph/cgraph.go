@@ -876,6 +876,22 @@ func (g *Graph) IsSimple() (bool, error) {
 	return res == 1, nil
 }
 
+func (g *Graph) IsDirected() (bool, error) {
+	res, err := wasm.IsDirected(context.Background(), g.wasm)
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
+func (g *Graph) IsStrict() (bool, error) {
+	res, err := wasm.IsStrict(context.Background(), g.wasm)
+	if err != nil {
+		return false, err
+	}
+	return res == 1, nil
+}
+
 func (g *Graph) CreateNodeByName(name string) (*Node, error) {
 	res, err := g.wasm.Node(context.Background(), name, 1)
 	if err != nil {
@@ -0,0 +1,622 @@
+package cgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+var ErrMergeConflict = errors.New("merge conflict")
+
+// MergePolicy decides the value of an attribute when both graphs set it to different values.
+type MergePolicy int
+
+const (
+	// MergeKeep keeps the value of the graph merged into.
+	MergeKeep MergePolicy = iota
+	// MergeOverwrite uses the value of the other graph.
+	MergeOverwrite
+	// MergeError returns ErrMergeConflict.
+	MergeError
+)
+
+// Clone returns a deep copy of the graph as a new root graph.
+// Attribute declarations, attribute values, subgraphs, nodes and edges are copied.
+// If g is a subgraph, the attributes inherited from the parent graphs are declared in the new root graph.
+func (g *Graph) Clone() (*Graph, error) {
+	return g.copyTo(nil)
+}
+
+// ExtractSubgraph returns a new root graph which has the nodes, the edges between them ( the induced subgraph )
+// and the attribute declarations of g.
+// Subgraphs of g which contain any of the nodes are copied with only those nodes.
+func (g *Graph) ExtractSubgraph(nodes []*Node) (*Graph, error) {
+	names := make(map[string]struct{}, len(nodes))
+	for _, n := range nodes {
+		name, err := n.Name()
+		if err != nil {
+			return nil, err
+		}
+		names[name] = struct{}{}
+	}
+	return g.copyTo(names)
+}
+
+// Merge adds the attribute declarations, subgraphs, nodes and edges of other to g.
+// Nodes and subgraphs are identified by the name, and edges by the tail, head and key.
+// When both graphs set an attribute to different values, policy decides the value.
+// With MergeError, g may be partially merged when the error is returned.
+func (g *Graph) Merge(other *Graph, policy MergePolicy) error {
+	c := newGraphCopier(policy, nil)
+	return c.copy(other, g)
+}
+
+// DisjointUnion returns a new root graph which has copies of all graphs side by side like the gvpack tool of Graphviz.
+// Nodes and subgraphs whose names are already used by the preceding graphs are renamed by appending "_gvN",
+// where N is the index of the graph, and renamed nodes keep the original name as the label.
+// The attribute values of the root graphs are taken from the first graph which sets them.
+// All graphs must be directed or all undirected.
+func DisjointUnion(graphs ...*Graph) (*Graph, error) {
+	if len(graphs) == 0 {
+		return nil, errors.New("no graphs to union")
+	}
+	desc, err := graphs[0].desc()
+	if err != nil {
+		return nil, err
+	}
+	directed, err := graphs[0].IsDirected()
+	if err != nil {
+		return nil, err
+	}
+	name, err := graphs[0].Name()
+	if err != nil {
+		return nil, err
+	}
+	dst, err := Open(name, desc, nil)
+	if err != nil {
+		return nil, err
+	}
+	subGraphNames := map[string]struct{}{}
+	for i, g := range graphs {
+		d, err := g.IsDirected()
+		if err != nil {
+			_ = dst.Close()
+			return nil, err
+		}
+		if d != directed {
+			_ = dst.Close()
+			return nil, errors.New("cannot union directed and undirected graphs")
+		}
+		c := newGraphCopier(MergeKeep, nil)
+		c.rename = func(kind ObjectTag, name string) (string, error) {
+			return uniqueName(dst, kind, name, i, subGraphNames)
+		}
+		if err := c.copy(g, dst); err != nil {
+			_ = dst.Close()
+			return nil, err
+		}
+	}
+	return dst, nil
+}
+
+// uniqueName returns the name which is not used in dst by appending "_gvN".
+// subGraphNames are the names of the subgraphs in dst which is updated with the returned name.
+func uniqueName(dst *Graph, kind ObjectTag, name string, index int, subGraphNames map[string]struct{}) (string, error) {
+	used := func(name string) (bool, error) {
+		if kind == GRAPH {
+			_, exists := subGraphNames[name]
+			return exists, nil
+		}
+		n, err := dst.NodeByName(name)
+		return n != nil, err
+	}
+	ret := name
+	for i := 0; ; i++ {
+		exists, err := used(ret)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			break
+		}
+		ret = fmt.Sprintf("%s_gv%d", name, index)
+		if i > 0 {
+			ret = fmt.Sprintf("%s_gv%d_%d", name, index, i)
+		}
+	}
+	if kind == GRAPH {
+		subGraphNames[ret] = struct{}{}
+	}
+	return ret, nil
+}
+
+func (g *Graph) copyTo(filter map[string]struct{}) (*Graph, error) {
+	desc, err := g.desc()
+	if err != nil {
+		return nil, err
+	}
+	name, err := g.Name()
+	if err != nil {
+		return nil, err
+	}
+	dst, err := Open(name, desc, nil)
+	if err != nil {
+		return nil, err
+	}
+	if err := newGraphCopier(MergeOverwrite, filter).copy(g, dst); err != nil {
+		_ = dst.Close()
+		return nil, err
+	}
+	return dst, nil
+}
+
+// desc returns the descriptor of the graph kind because Desc of the graph cannot be passed to Open.
+func (g *Graph) desc() (*Desc, error) {
+	directed, err := g.IsDirected()
+	if err != nil {
+		return nil, err
+	}
+	strict, err := g.IsStrict()
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case directed && strict:
+		return StrictDirected, nil
+	case directed:
+		return Directed, nil
+	case strict:
+		return StrictUnDirected, nil
+	}
+	return UnDirected, nil
+}
+
+// attributeObject is a graph, node or edge which has attributes.
+type attributeObject interface {
+	GetStr(name string) string
+	IsHTML(name string) bool
+	Set(name, value string) error
+	SetHTML(name, value string) error
+}
+
+// graphCopier copies the contents of a graph into another root graph.
+type graphCopier struct {
+	policy MergePolicy
+	// filter is the names of the nodes to copy. All nodes are copied if it is nil.
+	filter map[string]struct{}
+	// defaults are the attribute defaults of the destination root graph by kind.
+	defaults map[ObjectTag]map[string]string
+	// names are the attribute names of the source graph by kind.
+	names map[ObjectTag][]string
+	nodes map[string]*Node
+	edges map[ID]*Edge
+	// rename returns the name of the copied node or subgraph. Names are not changed if it is nil.
+	rename func(kind ObjectTag, name string) (string, error)
+}
+
+func newGraphCopier(policy MergePolicy, filter map[string]struct{}) *graphCopier {
+	return &graphCopier{
+		policy: policy,
+		filter: filter,
+		nodes:  map[string]*Node{},
+		edges:  map[ID]*Edge{},
+	}
+}
+
+var attributeTags = []ObjectTag{GRAPH, NODE, EDGE}
+
+func (c *graphCopier) copy(src, dst *Graph) error {
+	if err := c.copyDeclarations(src, dst); err != nil {
+		return err
+	}
+	if err := c.copyValues(GRAPH, src, dst, true); err != nil {
+		return err
+	}
+	if err := c.copyNodesAndEdges(src, dst); err != nil {
+		return err
+	}
+	return c.copySubGraphs(src, dst)
+}
+
+func (c *graphCopier) copyDeclarations(src, dst *Graph) error {
+	c.defaults = map[ObjectTag]map[string]string{}
+	c.names = map[ObjectTag][]string{}
+	for _, kind := range attributeTags {
+		dstDefaults, err := attributeDefaults(dst, kind)
+		if err != nil {
+			return err
+		}
+		for sym, err := range src.Attributes(kind) {
+			if err != nil {
+				return err
+			}
+			name, def := sym.Name(), sym.DefaultValue()
+			c.names[kind] = append(c.names[kind], name)
+			if cur, exists := dstDefaults[name]; exists {
+				if cur == def {
+					// declaring the attribute of the root graph again resets its value.
+					continue
+				}
+				if err := c.conflict(kind, "default", name, cur, def); err != nil {
+					return err
+				}
+				if c.policy == MergeKeep {
+					continue
+				}
+			}
+			attr := dst.Attr
+			if sym.IsHTML() {
+				attr = dst.AttrHTML
+			}
+			if _, err := attr(int(kind), name, def); err != nil {
+				return err
+			}
+		}
+		dstDefaults, err = attributeDefaults(dst, kind)
+		if err != nil {
+			return err
+		}
+		c.defaults[kind] = dstDefaults
+	}
+	return nil
+}
+
+// copyValues copies the attribute values of src to dst.
+// existed reports whether dst existed before copying, so its values may conflict with src.
+func (c *graphCopier) copyValues(kind ObjectTag, src, dst attributeObject, existed bool) error {
+	for _, name := range c.names[kind] {
+		v, cur := src.GetStr(name), dst.GetStr(name)
+		html := v != "" && src.IsHTML(name)
+		if v == cur && html == dst.IsHTML(name) {
+			continue
+		}
+		if existed && cur != c.defaults[kind][name] {
+			if err := c.conflict(kind, objectName(dst), name, cur, v); err != nil {
+				return err
+			}
+			if c.policy == MergeKeep {
+				continue
+			}
+		}
+		set := dst.Set
+		if html {
+			set = dst.SetHTML
+		}
+		if err := set(name, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *graphCopier) conflict(kind ObjectTag, object, name, cur, v string) error {
+	if c.policy != MergeError {
+		return nil
+	}
+	return fmt.Errorf("%w: %s attribute %s of %s is %q and %q", ErrMergeConflict, objectTagName(kind), name, object, cur, v)
+}
+
+func (c *graphCopier) copyNodesAndEdges(src, dst *Graph) error {
+	for n, err := range src.Nodes() {
+		if err != nil {
+			return err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return err
+		}
+		if !c.includes(name) {
+			continue
+		}
+		dstName, err := c.renamed(NODE, name)
+		if err != nil {
+			return err
+		}
+		dn, err := dst.NodeByName(dstName)
+		if err != nil {
+			return err
+		}
+		existed := dn != nil
+		if !existed {
+			dn, err = dst.CreateNodeByName(dstName)
+			if err != nil {
+				return err
+			}
+		}
+		if err := c.copyValues(NODE, n, dn, existed); err != nil {
+			return err
+		}
+		// the label of the renamed node is the original name unless it is set.
+		if label := dn.GetStr("label"); dstName != name && (label == "" || label == `\N`) {
+			if err := dn.SafeSet("label", name, `\N`); err != nil {
+				return err
+			}
+		}
+		c.nodes[name] = dn
+	}
+	// anonymous edges between the same nodes are matched in the order they appear.
+	anonymous := map[[2]string]int{}
+	for e, err := range src.Edges() {
+		if err != nil {
+			return err
+		}
+		tail, head, ok, err := c.endpoints(e)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		name, err := e.Name()
+		if err != nil {
+			return err
+		}
+		var de *Edge
+		if !isAnonymousEdgeName(name) {
+			de, err = dst.EdgeByName(name, tail, head)
+		} else {
+			key := [2]string{}
+			key[0], _ = tail.Name()
+			key[1], _ = head.Name()
+			de, err = nthAnonymousEdge(dst, tail, head, anonymous[key])
+			anonymous[key]++
+		}
+		if err != nil {
+			return err
+		}
+		existed := de != nil
+		if !existed {
+			if isAnonymousEdgeName(name) {
+				de, err = createAnonymousEdge(dst, tail, head)
+			} else {
+				de, err = dst.CreateEdgeByName(name, tail, head)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		if err := c.copyValues(EDGE, e, de, existed); err != nil {
+			return err
+		}
+		c.edges[e.Base().Tag().ID()] = de
+	}
+	return nil
+}
+
+// endpoints returns the copied tail and head of the edge. ok is false if they are not copied.
+func (c *graphCopier) endpoints(e *Edge) (*Node, *Node, bool, error) {
+	tail, err := e.Tail()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	head, err := e.Head()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	tailName, err := tail.Name()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	headName, err := head.Name()
+	if err != nil {
+		return nil, nil, false, err
+	}
+	dt, dh := c.nodes[tailName], c.nodes[headName]
+	return dt, dh, dt != nil && dh != nil, nil
+}
+
+func nthAnonymousEdge(g *Graph, tail, head *Node, nth int) (*Edge, error) {
+	headName, err := head.Name()
+	if err != nil {
+		return nil, err
+	}
+	for e, err := range g.OutEdges(tail) {
+		if err != nil {
+			return nil, err
+		}
+		if name, err := e.Name(); err != nil || !isAnonymousEdgeName(name) {
+			continue
+		}
+		h, err := e.Head()
+		if err != nil {
+			return nil, err
+		}
+		if name, _ := h.Name(); name != headName {
+			continue
+		}
+		if nth == 0 {
+			return e, nil
+		}
+		nth--
+	}
+	return nil, nil
+}
+
+// CreateAnonymousEdge creates an edge without a key between the nodes.
+// Unlike CreateEdgeByName with an empty name, it creates a new edge even if the nodes are already connected.
+func (g *Graph) CreateAnonymousEdge(tail, head *Node) (*Edge, error) {
+	return createAnonymousEdge(g, tail, head)
+}
+
+// isAnonymousEdgeName reports whether the edge has no key.
+func isAnonymousEdgeName(name string) bool {
+	return name == ""
+}
+
+// createAnonymousEdge creates an edge without a key between the nodes.
+// An empty name is also a key which matches the existing edge, so the edge is created without a name.
+func createAnonymousEdge(g *Graph, tail, head *Node) (*Edge, error) {
+	res, err := g.wasm.AnonymousEdge(context.Background(), tail.getWasm(), head.getWasm())
+	if err != nil {
+		return nil, err
+	}
+	return toEdge(res), nil
+}
+
+func (c *graphCopier) renamed(kind ObjectTag, name string) (string, error) {
+	if c.rename == nil {
+		return name, nil
+	}
+	return c.rename(kind, name)
+}
+
+func (c *graphCopier) includes(name string) bool {
+	if c.filter == nil {
+		return true
+	}
+	_, exists := c.filter[name]
+	return exists
+}
+
+func (c *graphCopier) copySubGraphs(src, dst *Graph) error {
+	sub, err := src.FirstSubGraph()
+	for ; sub != nil && err == nil; sub, err = sub.NextSubGraph() {
+		if err := c.copySubGraph(sub, dst); err != nil {
+			return err
+		}
+	}
+	return err
+}
+
+func (c *graphCopier) copySubGraph(src, dstParent *Graph) error {
+	var nodes []*Node
+	for n, err := range src.Nodes() {
+		if err != nil {
+			return err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return err
+		}
+		if dn := c.nodes[name]; dn != nil {
+			nodes = append(nodes, dn)
+		}
+	}
+	if c.filter != nil && len(nodes) == 0 {
+		return nil
+	}
+	name, err := src.Name()
+	if err != nil {
+		return err
+	}
+	if name, err = c.renamed(GRAPH, name); err != nil {
+		return err
+	}
+	dst, err := dstParent.SubGraphByName(name)
+	if err != nil {
+		return err
+	}
+	existed := dst != nil
+	if !existed {
+		dst, err = dstParent.CreateSubGraphByName(name)
+		if err != nil {
+			return err
+		}
+	}
+	if err := c.copyLocalDefaults(src, dst); err != nil {
+		return err
+	}
+	if err := c.copyValues(GRAPH, src, dst, existed); err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		if _, err := dst.CreateSubNode(n); err != nil {
+			return err
+		}
+	}
+	for e, err := range src.Edges() {
+		if err != nil {
+			return err
+		}
+		de := c.edges[e.Base().Tag().ID()]
+		if de == nil {
+			continue
+		}
+		if _, err := dst.CreateSubEdge(de); err != nil {
+			return err
+		}
+	}
+	return c.copySubGraphs(src, dst)
+}
+
+// copyLocalDefaults declares the node and edge attribute defaults which are overridden in the subgraph.
+// e.g. subgraph { node [color=red] }
+func (c *graphCopier) copyLocalDefaults(src, dst *Graph) error {
+	for _, kind := range []ObjectTag{NODE, EDGE} {
+		parentDefaults, err := attributeDefaults(src.Parent(), kind)
+		if err != nil {
+			return err
+		}
+		dstDefaults, err := attributeDefaults(dst, kind)
+		if err != nil {
+			return err
+		}
+		dstParentDefaults, err := attributeDefaults(dst.Parent(), kind)
+		if err != nil {
+			return err
+		}
+		for sym, err := range src.Attributes(kind) {
+			if err != nil {
+				return err
+			}
+			name, def := sym.Name(), sym.DefaultValue()
+			if def == parentDefaults[name] || def == dstDefaults[name] {
+				continue
+			}
+			if cur := dstDefaults[name]; cur != dstParentDefaults[name] {
+				if err := c.conflict(kind, "default", name, cur, def); err != nil {
+					return err
+				}
+				if c.policy == MergeKeep {
+					continue
+				}
+			}
+			attr := dst.Attr
+			if sym.IsHTML() {
+				attr = dst.AttrHTML
+			}
+			if _, err := attr(int(kind), name, def); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// attributeDefaults returns the default values of the attributes declared for kind in the graph.
+func attributeDefaults(g *Graph, kind ObjectTag) (map[string]string, error) {
+	ret := map[string]string{}
+	for sym, err := range g.Attributes(kind) {
+		if err != nil {
+			return nil, err
+		}
+		ret[sym.Name()] = sym.DefaultValue()
+	}
+	return ret, nil
+}
+
+func objectName(obj attributeObject) string {
+	var (
+		name string
+		err  error
+	)
+	switch obj := obj.(type) {
+	case *Graph:
+		name, err = obj.Name()
+	case *Node:
+		name, err = obj.Name()
+	case *Edge:
+		var tail, head *Node
+		if tail, err = obj.Tail(); err == nil {
+			if head, err = obj.Head(); err == nil {
+				t, _ := tail.Name()
+				h, _ := head.Name()
+				name = t + " -> " + h
+			}
+		}
+	}
+	if err != nil {
+		return ""
+	}
+	return name
+}
@@ -0,0 +1,243 @@
+package cgraph
+
+import (
+	"fmt"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Color is a value of color type described in https://graphviz.org/docs/attr-types/color/ .
+// e.g. "red", "#ff000080", "0.6 0.4 1.0" or "/blues9/3".
+//
+// Color embeds the resolved color.NRGBA, so it implements color.Color.
+// A named color keeps its name and scheme and is written back as is by String.
+type Color struct {
+	color.NRGBA
+	// Scheme is the color scheme of Name. e.g. "x11", "svg" or "blues9".
+	// It is empty if the value does not specify the scheme.
+	Scheme string
+	// Name is the color name. It is a number such as "3" for a Brewer scheme.
+	// It is empty if the color is specified by the components.
+	Name string
+}
+
+// RGB returns an opaque color.
+func RGB(r, g, b uint8) Color {
+	return Color{NRGBA: color.NRGBA{R: r, G: g, B: b, A: 0xff}}
+}
+
+// RGBA returns a color with the non alpha-premultiplied components.
+func RGBA(r, g, b, a uint8) Color {
+	return Color{NRGBA: color.NRGBA{R: r, G: g, B: b, A: a}}
+}
+
+// HSV returns an opaque color from hue, saturation and value in the range [0, 1].
+func HSV(h, s, v float64) Color {
+	r, g, b := hsvToRGB(h, s, v)
+	return RGB(r, g, b)
+}
+
+// NamedColor returns the color of the name in the scheme.
+// scheme is "x11" ( the default if it is empty ), "svg" or a Brewer scheme such as "blues9".
+func NamedColor(scheme, name string) (Color, error) {
+	c, ok := resolveColorName(scheme, name)
+	if !ok {
+		if _, exists := colorSchemeSize(scheme); !exists {
+			return Color{}, fmt.Errorf("%w: %q", ErrUnknownColorScheme, scheme)
+		}
+		return Color{}, fmt.Errorf("%w: %q", ErrUnknownColor, name)
+	}
+	return Color{NRGBA: c, Scheme: scheme, Name: name}, nil
+}
+
+// ParseColor parses a color value. Color names without a scheme are resolved with the X11 scheme.
+func ParseColor(v string) (Color, error) {
+	return parseColor(v, "")
+}
+
+// ParseColorList parses a colorList value such as "red;0.3:blue".
+func ParseColorList(v string) (ColorList, error) {
+	return parseColorList(v, "")
+}
+
+// ToRGBA returns the color as the alpha-premultiplied color.RGBA.
+func (c Color) ToRGBA() color.RGBA {
+	return color.RGBAModel.Convert(c.NRGBA).(color.RGBA)
+}
+
+// HSV returns hue, saturation and value of the color in the range [0, 1].
+func (c Color) HSV() (h, s, v float64) {
+	r, g, b := float64(c.R)/0xff, float64(c.G)/0xff, float64(c.B)/0xff
+	maxV, minV := math.Max(r, math.Max(g, b)), math.Min(r, math.Min(g, b))
+	v = maxV
+	if maxV == 0 {
+		return 0, 0, v
+	}
+	s = (maxV - minV) / maxV
+	if s == 0 {
+		return 0, s, v
+	}
+	delta := maxV - minV
+	switch maxV {
+	case r:
+		h = (g - b) / delta
+	case g:
+		h = 2 + (b-r)/delta
+	default:
+		h = 4 + (r-g)/delta
+	}
+	h /= 6
+	if h < 0 {
+		h++
+	}
+	return h, s, v
+}
+
+// String returns the value in the DOT format.
+func (c Color) String() string {
+	if c.Name != "" {
+		if c.Scheme != "" {
+			return fmt.Sprintf("/%s/%s", c.Scheme, c.Name)
+		}
+		return c.Name
+	}
+	if c.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
+
+// WeightedColor is an element of colorList type. e.g. "red;0.3".
+type WeightedColor struct {
+	Color Color
+	// Weight is the proportion of the area covered by the color. It is 0 if not specified.
+	Weight float64
+}
+
+// ColorList is a value of colorList type. e.g. "red;0.3:green:blue".
+// It is used for the striped and wedged fills and parallel edges.
+type ColorList []WeightedColor
+
+// String returns the value in the DOT format.
+func (l ColorList) String() string {
+	colors := make([]string, 0, len(l))
+	for _, c := range l {
+		if c.Weight != 0 {
+			colors = append(colors, fmt.Sprintf("%s;%g", c.Color, c.Weight))
+			continue
+		}
+		colors = append(colors, c.Color.String())
+	}
+	return strings.Join(colors, ":")
+}
+
+// parseColor parses v resolving color names without a scheme with scheme.
+func parseColor(v, scheme string) (Color, error) {
+	v = strings.TrimSpace(v)
+	if hex, found := strings.CutPrefix(v, "#"); found {
+		return parseHexColor(hex)
+	}
+	if v != "" && (v[0] == '.' || (v[0] >= '0' && v[0] <= '9')) {
+		if hsv := strings.FieldsFunc(v, func(r rune) bool { return r == ',' || r == ' ' }); len(hsv) > 1 {
+			return parseHSVColor(hsv)
+		}
+	}
+	if rest, found := strings.CutPrefix(v, "/"); found {
+		s, n, found := strings.Cut(rest, "/")
+		if !found {
+			return Color{}, fmt.Errorf("%w: %q", ErrUnknownColor, v)
+		}
+		return NamedColor(s, n)
+	}
+	c, ok := resolveColorName(scheme, v)
+	if !ok {
+		return Color{}, fmt.Errorf("%w: %q", ErrUnknownColor, v)
+	}
+	// the scheme comes from the object, so it is not a part of the value.
+	return Color{NRGBA: c, Name: v}, nil
+}
+
+func parseHexColor(hex string) (Color, error) {
+	if len(hex) != 6 && len(hex) != 8 {
+		return Color{}, fmt.Errorf("%w: %q", ErrUnknownColor, "#"+hex)
+	}
+	c, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("%w: %q", ErrUnknownColor, "#"+hex)
+	}
+	if len(hex) == 6 {
+		return RGB(uint8(c>>16), uint8(c>>8), uint8(c)), nil
+	}
+	return RGBA(uint8(c>>24), uint8(c>>16), uint8(c>>8), uint8(c)), nil
+}
+
+// parseHSVColor parses "H,S,V" or "H,S,V,A" whose components are in the range [0, 1].
+func parseHSVColor(fields []string) (Color, error) {
+	if len(fields) != 3 && len(fields) != 4 {
+		return Color{}, fmt.Errorf("%w: unexpected number of HSV components %d", ErrUnknownColor, len(fields))
+	}
+	values := make([]float64, 0, len(fields))
+	for _, f := range fields {
+		d, err := parseDouble(f)
+		if err != nil || d < 0 || d > 1 {
+			return Color{}, fmt.Errorf("%w: invalid HSV component %q", ErrUnknownColor, f)
+		}
+		values = append(values, d)
+	}
+	c := HSV(values[0], values[1], values[2])
+	if len(values) == 4 {
+		c.A = uint8(math.Round(values[3] * 0xff))
+	}
+	return c, nil
+}
+
+func hsvToRGB(h, s, v float64) (uint8, uint8, uint8) {
+	if s <= 0 {
+		c := uint8(math.Round(v * 0xff))
+		return c, c, c
+	}
+	h = math.Mod(h, 1) * 6
+	i := math.Floor(h)
+	f := h - i
+	p, q, t := v*(1-s), v*(1-s*f), v*(1-s*(1-f))
+	var r, g, b float64
+	switch int(i) {
+	case 0:
+		r, g, b = v, t, p
+	case 1:
+		r, g, b = q, v, p
+	case 2:
+		r, g, b = p, v, t
+	case 3:
+		r, g, b = p, q, v
+	case 4:
+		r, g, b = t, p, v
+	default:
+		r, g, b = v, p, q
+	}
+	return uint8(math.Round(r * 0xff)), uint8(math.Round(g * 0xff)), uint8(math.Round(b * 0xff))
+}
+
+// parseColorList parses v resolving color names without a scheme with scheme.
+func parseColorList(v, scheme string) (ColorList, error) {
+	var ret ColorList
+	for _, s := range strings.Split(v, ":") {
+		name, weight, found := strings.Cut(s, ";")
+		c, err := parseColor(name, scheme)
+		if err != nil {
+			return nil, err
+		}
+		wc := WeightedColor{Color: c}
+		if found {
+			w, err := parseDouble(weight)
+			if err != nil {
+				return nil, fmt.Errorf("invalid color weight %q", weight)
+			}
+			wc.Weight = w
+		}
+		ret = append(ret, wc)
+	}
+	return ret, nil
+}
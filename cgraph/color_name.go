@@ -0,0 +1,196 @@
+package cgraph
+
+import (
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+
+	"golang.org/x/image/colornames"
+)
+
+// x11Colors are the colors of the X11 color scheme which are not defined by SVG or differ from it.
+// The other X11 colors are the same as SVG.
+var x11Colors = map[string]uint32{
+	"gray":           0xbebebe,
+	"grey":           0xbebebe,
+	"green":          0x00ff00,
+	"maroon":         0xb03060,
+	"purple":         0xa020f0,
+	"lightgoldenrod": 0xeedd82,
+	"lightslateblue": 0x8470ff,
+	"navyblue":       0x000080,
+	"violetred":      0xd02090,
+	"webgray":        0x808080,
+	"webgreen":       0x008000,
+	"webgrey":        0x808080,
+	"webmaroon":      0x800000,
+	"webpurple":      0x800080,
+	"x11gray":        0xbebebe,
+	"x11green":       0x00ff00,
+	"x11grey":        0xbebebe,
+	"x11maroon":      0xb03060,
+	"x11purple":      0xa020f0,
+}
+
+// x11NumberedColors are the X11 colors suffixed with 1. e.g. "red1".
+// The variants suffixed with 2 to 4 are darker versions of them.
+var x11NumberedColors = map[string]uint32{
+	"antiquewhite":   0xffefdb,
+	"aquamarine":     0x7fffd4,
+	"azure":          0xf0ffff,
+	"bisque":         0xffe4c4,
+	"blue":           0x0000ff,
+	"brown":          0xff4040,
+	"burlywood":      0xffd39b,
+	"cadetblue":      0x98f5ff,
+	"chartreuse":     0x7fff00,
+	"chocolate":      0xff7f24,
+	"coral":          0xff7256,
+	"cornsilk":       0xfff8dc,
+	"cyan":           0x00ffff,
+	"darkgoldenrod":  0xffb90f,
+	"darkolivegreen": 0xcaff70,
+	"darkorange":     0xff7f00,
+	"darkorchid":     0xbf3eff,
+	"darkseagreen":   0xc1ffc1,
+	"darkslategray":  0x97ffff,
+	"deeppink":       0xff1493,
+	"deepskyblue":    0x00bfff,
+	"dodgerblue":     0x1e90ff,
+	"firebrick":      0xff3030,
+	"gold":           0xffd700,
+	"goldenrod":      0xffc125,
+	"green":          0x00ff00,
+	"honeydew":       0xf0fff0,
+	"hotpink":        0xff6eb4,
+	"indianred":      0xff6a6a,
+	"ivory":          0xfffff0,
+	"khaki":          0xfff68f,
+	"lavenderblush":  0xfff0f5,
+	"lemonchiffon":   0xfffacd,
+	"lightblue":      0xbfefff,
+	"lightcyan":      0xe0ffff,
+	"lightgoldenrod": 0xffec8b,
+	"lightpink":      0xffaeb9,
+	"lightsalmon":    0xffa07a,
+	"lightskyblue":   0xb0e2ff,
+	"lightsteelblue": 0xcae1ff,
+	"lightyellow":    0xffffe0,
+	"magenta":        0xff00ff,
+	"maroon":         0xff34b3,
+	"mediumorchid":   0xe066ff,
+	"mediumpurple":   0xab82ff,
+	"mistyrose":      0xffe4e1,
+	"navajowhite":    0xffdead,
+	"olivedrab":      0xc0ff3e,
+	"orange":         0xffa500,
+	"orangered":      0xff4500,
+	"orchid":         0xff83fa,
+	"palegreen":      0x9aff9a,
+	"paleturquoise":  0xbbffff,
+	"palevioletred":  0xff82ab,
+	"peachpuff":      0xffdab9,
+	"pink":           0xffb5c5,
+	"plum":           0xffbbff,
+	"purple":         0x9b30ff,
+	"red":            0xff0000,
+	"rosybrown":      0xffc1c1,
+	"royalblue":      0x4876ff,
+	"salmon":         0xff8c69,
+	"seagreen":       0x54ff9f,
+	"seashell":       0xfff5ee,
+	"sienna":         0xff8247,
+	"skyblue":        0x87ceff,
+	"slateblue":      0x836fff,
+	"slategray":      0xc6e2ff,
+	"snow":           0xfffafa,
+	"springgreen":    0x00ff7f,
+	"steelblue":      0x63b8ff,
+	"tan":            0xffa54f,
+	"thistle":        0xffe1ff,
+	"tomato":         0xff6347,
+	"turquoise":      0x00f5ff,
+	"violetred":      0xff3e96,
+	"wheat":          0xffe7ba,
+	"yellow":         0xffff00,
+}
+
+// x11VariantScales are the brightness of the X11 color variants suffixed with 1 to 4.
+var x11VariantScales = []float64{1, 238.0 / 255, 205.0 / 255, 139.0 / 255}
+
+// transparentColor is the color named "transparent" in every scheme.
+var transparentColor = color.NRGBA{R: 0xff, G: 0xff, B: 0xfe, A: 0}
+
+// colorSchemeSize returns the number of colors of the Brewer color scheme.
+// It returns 0 for the "x11" and "svg" schemes which are not indexed by number.
+func colorSchemeSize(scheme string) (int, bool) {
+	switch strings.ToLower(scheme) {
+	case "", "x11", "svg":
+		return 0, true
+	}
+	colors, exists := brewerColors(strings.ToLower(scheme))
+	return len(colors), exists
+}
+
+// resolveColorName returns the color of the name in the scheme.
+// Like Graphviz, names are compared ignoring case and spaces.
+func resolveColorName(scheme, name string) (color.NRGBA, bool) {
+	scheme = strings.ToLower(scheme)
+	name = strings.ToLower(strings.ReplaceAll(name, " ", ""))
+	if name == "transparent" {
+		return transparentColor, true
+	}
+	switch scheme {
+	case "", "x11":
+		return resolveX11ColorName(name)
+	case "svg":
+		c, exists := colornames.Map[name]
+		return color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}, exists
+	}
+	colors, exists := brewerColors(scheme)
+	if !exists {
+		return color.NRGBA{}, false
+	}
+	i, err := strconv.Atoi(name)
+	if err != nil || i < 1 || i > len(colors) {
+		return color.NRGBA{}, false
+	}
+	return hexColor(colors[i-1]), true
+}
+
+func resolveX11ColorName(name string) (color.NRGBA, bool) {
+	if c, exists := x11Colors[name]; exists {
+		return hexColor(c), true
+	}
+	if c, exists := colornames.Map[name]; exists {
+		return color.NRGBA{R: c.R, G: c.G, B: c.B, A: c.A}, true
+	}
+	for _, prefix := range []string{"gray", "grey"} {
+		if level, found := strings.CutPrefix(name, prefix); found {
+			i, err := strconv.Atoi(level)
+			if err != nil || i < 0 || i > 100 {
+				return color.NRGBA{}, false
+			}
+			v := uint8(math.Round(float64(i) * 2.55))
+			return color.NRGBA{R: v, G: v, B: v, A: 0xff}, true
+		}
+	}
+	if len(name) < 2 || name[len(name)-1] < '1' || name[len(name)-1] > '4' {
+		return color.NRGBA{}, false
+	}
+	c, exists := x11NumberedColors[name[:len(name)-1]]
+	if !exists {
+		return color.NRGBA{}, false
+	}
+	scale := x11VariantScales[name[len(name)-1]-'1']
+	ret := hexColor(c)
+	ret.R = uint8(math.Round(float64(ret.R) * scale))
+	ret.G = uint8(math.Round(float64(ret.G) * scale))
+	ret.B = uint8(math.Round(float64(ret.B) * scale))
+	return ret, true
+}
+
+func hexColor(c uint32) color.NRGBA {
+	return color.NRGBA{R: uint8(c >> 16), G: uint8(c >> 8), B: uint8(c), A: 0xff}
+}
@@ -0,0 +1,274 @@
+package cgraph
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ConnectedComponents returns the connected components of the graph as new root graphs like the ccomps tool of Graphviz.
+// The direction of edges is ignored. Each component has the attribute declarations of g,
+// and the subgraphs of g which contain its nodes.
+// Components are ordered by their first nodes in g.
+func ConnectedComponents(g *Graph) ([]*Graph, error) {
+	adj, nodes, err := adjacency(g, false)
+	if err != nil {
+		return nil, err
+	}
+	visited := map[string]bool{}
+	var components []*Graph
+	for _, start := range nodes {
+		if visited[start.name] {
+			continue
+		}
+		var members []*Node
+		visited[start.name] = true
+		stack := []*adjacencyNode{start}
+		for len(stack) > 0 {
+			n := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			members = append(members, n.node)
+			for _, next := range adj[n.name] {
+				if !visited[next.name] {
+					visited[next.name] = true
+					stack = append(stack, next)
+				}
+			}
+		}
+		component, err := g.ExtractSubgraph(members)
+		if err != nil {
+			for _, c := range components {
+				_ = c.Close()
+			}
+			return nil, err
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
+
+// SCCMap is the result of StronglyConnectedComponents.
+type SCCMap struct {
+	// Components are the node names of the strongly connected components in the topological order of Map.
+	Components [][]string
+	// Graph is a copy of the graph where the nodes of each component with two or more nodes
+	// are grouped by a cluster subgraph named "cluster_N". N is the index of Components.
+	Graph *Graph
+	// Map is the condensation of the graph named "scc_map".
+	// A component with two or more nodes is collapsed into a node named "cluster_N",
+	// and a component of a single node is the node of the same name. It has an edge for each pair of connected components.
+	Map *Graph
+}
+
+// Close closes Graph and Map.
+func (m *SCCMap) Close() error {
+	return errors.Join(m.Graph.Close(), m.Map.Close())
+}
+
+// StronglyConnectedComponents decomposes the directed graph into strongly connected components like the sccmap tool of Graphviz.
+func StronglyConnectedComponents(g *Graph) (*SCCMap, error) {
+	directed, err := g.IsDirected()
+	if err != nil {
+		return nil, err
+	}
+	if !directed {
+		return nil, errors.New("strongly connected components require a directed graph")
+	}
+	adj, nodes, err := adjacency(g, true)
+	if err != nil {
+		return nil, err
+	}
+	components := tarjan(adj, nodes)
+
+	clone, err := g.Clone()
+	if err != nil {
+		return nil, err
+	}
+	m := &SCCMap{Components: components, Graph: clone}
+	if err := m.build(); err != nil {
+		_ = clone.Close()
+		if m.Map != nil {
+			_ = m.Map.Close()
+		}
+		return nil, err
+	}
+	return m, nil
+}
+
+func (m *SCCMap) build() error {
+	// componentNames are the names of the map nodes by the node names.
+	componentNames := map[string]string{}
+	for i, c := range m.Components {
+		name := c[0]
+		if len(c) > 1 {
+			name = fmt.Sprintf("cluster_%d", i)
+		}
+		for _, n := range c {
+			componentNames[n] = name
+		}
+	}
+	clusters := map[string]*Graph{}
+	for i, c := range m.Components {
+		if len(c) == 1 {
+			continue
+		}
+		cluster, err := m.Graph.CreateSubGraphByName(fmt.Sprintf("cluster_%d", i))
+		if err != nil {
+			return err
+		}
+		for _, name := range c {
+			n, err := m.Graph.NodeByName(name)
+			if err != nil {
+				return err
+			}
+			if _, err := cluster.CreateSubNode(n); err != nil {
+				return err
+			}
+		}
+		clusters[componentNames[c[0]]] = cluster
+	}
+
+	sccMap, err := Open("scc_map", StrictDirected, nil)
+	if err != nil {
+		return err
+	}
+	m.Map = sccMap
+	mapNodes := map[string]*Node{}
+	for _, c := range m.Components {
+		name := componentNames[c[0]]
+		n, err := sccMap.CreateNodeByName(name)
+		if err != nil {
+			return err
+		}
+		mapNodes[name] = n
+	}
+	for e, err := range m.Graph.Edges() {
+		if err != nil {
+			return err
+		}
+		k, err := edgeKey(e)
+		if err != nil {
+			return err
+		}
+		tail, head := componentNames[k.Tail], componentNames[k.Head]
+		if tail != head {
+			if _, err := sccMap.CreateEdgeByName("", mapNodes[tail], mapNodes[head]); err != nil {
+				return err
+			}
+			continue
+		}
+		if cluster := clusters[tail]; cluster != nil {
+			if _, err := cluster.CreateSubEdge(e); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type adjacencyNode struct {
+	name string
+	node *Node
+}
+
+// adjacency returns the adjacent nodes by the node names and the nodes in the order of g.
+// If directed is false, the edges are followed in both directions.
+func adjacency(g *Graph, directed bool) (map[string][]*adjacencyNode, []*adjacencyNode, error) {
+	adj := map[string][]*adjacencyNode{}
+	byName := map[string]*adjacencyNode{}
+	var nodes []*adjacencyNode
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return nil, nil, err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return nil, nil, err
+		}
+		an := &adjacencyNode{name: name, node: n}
+		byName[name] = an
+		nodes = append(nodes, an)
+	}
+	for e, err := range g.Edges() {
+		if err != nil {
+			return nil, nil, err
+		}
+		k, err := edgeKey(e)
+		if err != nil {
+			return nil, nil, err
+		}
+		adj[k.Tail] = append(adj[k.Tail], byName[k.Head])
+		if !directed {
+			adj[k.Head] = append(adj[k.Head], byName[k.Tail])
+		}
+	}
+	return adj, nodes, nil
+}
+
+// tarjan returns the strongly connected components in topological order by Tarjan's algorithm.
+func tarjan(adj map[string][]*adjacencyNode, nodes []*adjacencyNode) [][]string {
+	var (
+		index      = map[string]int{}
+		lowLink    = map[string]int{}
+		onStack    = map[string]bool{}
+		stack      []string
+		components [][]string
+	)
+	type frame struct {
+		name string
+		next int
+	}
+	for _, start := range nodes {
+		if _, visited := index[start.name]; visited {
+			continue
+		}
+		// the depth-first search is iterative to handle long paths.
+		frames := []*frame{{name: start.name}}
+		index[start.name], lowLink[start.name] = len(index), len(index)
+		stack = append(stack, start.name)
+		onStack[start.name] = true
+		for len(frames) > 0 {
+			f := frames[len(frames)-1]
+			if f.next < len(adj[f.name]) {
+				w := adj[f.name][f.next].name
+				f.next++
+				if _, visited := index[w]; !visited {
+					index[w], lowLink[w] = len(index), len(index)
+					stack = append(stack, w)
+					onStack[w] = true
+					frames = append(frames, &frame{name: w})
+				} else if onStack[w] {
+					lowLink[f.name] = min(lowLink[f.name], index[w])
+				}
+				continue
+			}
+			frames = frames[:len(frames)-1]
+			if len(frames) > 0 {
+				parent := frames[len(frames)-1].name
+				lowLink[parent] = min(lowLink[parent], lowLink[f.name])
+			}
+			if lowLink[f.name] != index[f.name] {
+				continue
+			}
+			var component []string
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				component = append(component, w)
+				if w == f.name {
+					break
+				}
+			}
+			// nodes are popped in reverse order of the visit.
+			for i, j := 0, len(component)-1; i < j; i, j = i+1, j-1 {
+				component[i], component[j] = component[j], component[i]
+			}
+			components = append(components, component)
+		}
+	}
+	// Tarjan's algorithm finds the components in reverse topological order.
+	for i, j := 0, len(components)-1; i < j; i, j = i+1, j-1 {
+		components[i], components[j] = components[j], components[i]
+	}
+	return components
+}
@@ -0,0 +1,447 @@
+package cgraph
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AttributeChange is a change of an attribute value. Old or New is empty if the attribute is not set.
+type AttributeChange struct {
+	Name string
+	Old  string
+	New  string
+}
+
+// EdgeKey identifies an edge in Diff.
+type EdgeKey struct {
+	Tail string
+	Head string
+	// Key is the key ( name ) of the edge. It is empty if the edge has no key.
+	Key string
+	// Index is the index among the parallel edges without a key between the same nodes.
+	Index int
+}
+
+func (k EdgeKey) String() string {
+	s := k.Tail + " -> " + k.Head
+	if k.Key != "" {
+		return fmt.Sprintf("%s [key=%s]", s, k.Key)
+	}
+	if k.Index > 0 {
+		return fmt.Sprintf("%s #%d", s, k.Index+1)
+	}
+	return s
+}
+
+// NodeChange is the attribute changes of a node.
+type NodeChange struct {
+	Name    string
+	Changes []*AttributeChange
+}
+
+// EdgeChange is the attribute changes of an edge.
+type EdgeChange struct {
+	Edge    EdgeKey
+	Changes []*AttributeChange
+}
+
+// SubGraphChange is the changes of a subgraph which exists in both graphs.
+type SubGraphChange struct {
+	Name         string
+	Changes      []*AttributeChange
+	AddedNodes   []string
+	RemovedNodes []string
+}
+
+// GraphDiff is the structural difference between two graphs.
+// Nodes and subgraphs are identified by the name, and edges by EdgeKey.
+type GraphDiff struct {
+	// Changes are the attribute changes of the root graph.
+	Changes          []*AttributeChange
+	AddedNodes       []string
+	RemovedNodes     []string
+	ChangedNodes     []*NodeChange
+	AddedEdges       []EdgeKey
+	RemovedEdges     []EdgeKey
+	ChangedEdges     []*EdgeChange
+	AddedSubGraphs   []string
+	RemovedSubGraphs []string
+	ChangedSubGraphs []*SubGraphChange
+}
+
+// Empty reports whether the graphs have no differences.
+func (d *GraphDiff) Empty() bool {
+	return len(d.Changes) == 0 &&
+		len(d.AddedNodes) == 0 && len(d.RemovedNodes) == 0 && len(d.ChangedNodes) == 0 &&
+		len(d.AddedEdges) == 0 && len(d.RemovedEdges) == 0 && len(d.ChangedEdges) == 0 &&
+		len(d.AddedSubGraphs) == 0 && len(d.RemovedSubGraphs) == 0 && len(d.ChangedSubGraphs) == 0
+}
+
+// String returns the differences in the unified diff like format.
+// Lines start with '+' for added, '-' for removed and '~' for changed items.
+func (d *GraphDiff) String() string {
+	var b strings.Builder
+	writeChanges(&b, "graph", d.Changes)
+	for _, name := range d.AddedSubGraphs {
+		fmt.Fprintf(&b, "+ subgraph %s\n", name)
+	}
+	for _, name := range d.RemovedSubGraphs {
+		fmt.Fprintf(&b, "- subgraph %s\n", name)
+	}
+	for _, c := range d.ChangedSubGraphs {
+		writeChanges(&b, "subgraph "+c.Name, c.Changes)
+		for _, name := range c.AddedNodes {
+			fmt.Fprintf(&b, "+ subgraph %s: node %s\n", c.Name, name)
+		}
+		for _, name := range c.RemovedNodes {
+			fmt.Fprintf(&b, "- subgraph %s: node %s\n", c.Name, name)
+		}
+	}
+	for _, name := range d.AddedNodes {
+		fmt.Fprintf(&b, "+ node %s\n", name)
+	}
+	for _, name := range d.RemovedNodes {
+		fmt.Fprintf(&b, "- node %s\n", name)
+	}
+	for _, c := range d.ChangedNodes {
+		writeChanges(&b, "node "+c.Name, c.Changes)
+	}
+	for _, k := range d.AddedEdges {
+		fmt.Fprintf(&b, "+ edge %s\n", k)
+	}
+	for _, k := range d.RemovedEdges {
+		fmt.Fprintf(&b, "- edge %s\n", k)
+	}
+	for _, c := range d.ChangedEdges {
+		writeChanges(&b, "edge "+c.Edge.String(), c.Changes)
+	}
+	return b.String()
+}
+
+func writeChanges(b *strings.Builder, object string, changes []*AttributeChange) {
+	for _, c := range changes {
+		fmt.Fprintf(b, "~ %s: %s %q -> %q\n", object, c.Name, c.Old, c.New)
+	}
+}
+
+// Diff returns the differences from a to b.
+// Attribute values are compared including the values inherited from the defaults.
+func Diff(a, b *Graph) (*GraphDiff, error) {
+	d := &GraphDiff{}
+	names := map[ObjectTag][]string{}
+	for _, kind := range attributeTags {
+		n, err := attributeNames(kind, a, b)
+		if err != nil {
+			return nil, err
+		}
+		names[kind] = n
+	}
+	d.Changes = diffAttributes(names[GRAPH], a, b)
+
+	aNodes, err := nodesByName(a)
+	if err != nil {
+		return nil, err
+	}
+	bNodes, err := nodesByName(b)
+	if err != nil {
+		return nil, err
+	}
+	d.AddedNodes, d.RemovedNodes = diffKeys(aNodes, bNodes)
+	for _, name := range sortedKeys(aNodes) {
+		bn, exists := bNodes[name]
+		if !exists {
+			continue
+		}
+		if changes := diffAttributes(names[NODE], aNodes[name], bn); len(changes) != 0 {
+			d.ChangedNodes = append(d.ChangedNodes, &NodeChange{Name: name, Changes: changes})
+		}
+	}
+
+	aEdges, err := edgesByKey(a)
+	if err != nil {
+		return nil, err
+	}
+	bEdges, err := edgesByKey(b)
+	if err != nil {
+		return nil, err
+	}
+	d.AddedEdges, d.RemovedEdges = diffKeys(aEdges, bEdges)
+	for _, k := range sortedKeys(aEdges) {
+		be, exists := bEdges[k]
+		if !exists {
+			continue
+		}
+		if changes := diffAttributes(names[EDGE], aEdges[k], be); len(changes) != 0 {
+			d.ChangedEdges = append(d.ChangedEdges, &EdgeChange{Edge: k, Changes: changes})
+		}
+	}
+
+	aSubGraphs, err := subGraphsByName(a)
+	if err != nil {
+		return nil, err
+	}
+	bSubGraphs, err := subGraphsByName(b)
+	if err != nil {
+		return nil, err
+	}
+	d.AddedSubGraphs, d.RemovedSubGraphs = diffKeys(aSubGraphs, bSubGraphs)
+	for _, name := range sortedKeys(aSubGraphs) {
+		bs, exists := bSubGraphs[name]
+		if !exists {
+			continue
+		}
+		c, err := diffSubGraph(names[GRAPH], name, aSubGraphs[name], bs)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			d.ChangedSubGraphs = append(d.ChangedSubGraphs, c)
+		}
+	}
+	return d, nil
+}
+
+func diffSubGraph(names []string, name string, a, b *Graph) (*SubGraphChange, error) {
+	aNodes, err := nodesByName(a)
+	if err != nil {
+		return nil, err
+	}
+	bNodes, err := nodesByName(b)
+	if err != nil {
+		return nil, err
+	}
+	c := &SubGraphChange{Name: name, Changes: diffAttributes(names, a, b)}
+	c.AddedNodes, c.RemovedNodes = diffKeys(aNodes, bNodes)
+	if len(c.Changes) == 0 && len(c.AddedNodes) == 0 && len(c.RemovedNodes) == 0 {
+		return nil, nil
+	}
+	return c, nil
+}
+
+// attributeNames returns the sorted names of the attributes declared for kind in either graph.
+func attributeNames(kind ObjectTag, graphs ...*Graph) ([]string, error) {
+	var names []string
+	for _, g := range graphs {
+		for sym, err := range g.Attributes(kind) {
+			if err != nil {
+				return nil, err
+			}
+			if !slices.Contains(names, sym.Name()) {
+				names = append(names, sym.Name())
+			}
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
+
+func diffAttributes(names []string, a, b attributeObject) []*AttributeChange {
+	var changes []*AttributeChange
+	for _, name := range names {
+		if v, w := a.GetStr(name), b.GetStr(name); v != w {
+			changes = append(changes, &AttributeChange{Name: name, Old: v, New: w})
+		}
+	}
+	return changes
+}
+
+// diffKeys returns the sorted keys only in b ( added ) and only in a ( removed ).
+func diffKeys[K comparable, V any](a, b map[K]V) ([]K, []K) {
+	var added, removed []K
+	for _, k := range sortedKeys(b) {
+		if _, exists := a[k]; !exists {
+			added = append(added, k)
+		}
+	}
+	for _, k := range sortedKeys(a) {
+		if _, exists := b[k]; !exists {
+			removed = append(removed, k)
+		}
+	}
+	return added, removed
+}
+
+func sortedKeys[K comparable, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.SortFunc(keys, func(x, y K) int {
+		return strings.Compare(fmt.Sprint(x), fmt.Sprint(y))
+	})
+	return keys
+}
+
+func nodesByName(g *Graph) (map[string]*Node, error) {
+	nodes := map[string]*Node{}
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return nil, err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return nil, err
+		}
+		nodes[name] = n
+	}
+	return nodes, nil
+}
+
+func edgesByKey(g *Graph) (map[EdgeKey]*Edge, error) {
+	edges := map[EdgeKey]*Edge{}
+	for e, err := range g.Edges() {
+		if err != nil {
+			return nil, err
+		}
+		k, err := edgeKey(e)
+		if err != nil {
+			return nil, err
+		}
+		for _, exists := edges[k]; exists; _, exists = edges[k] {
+			k.Index++
+		}
+		edges[k] = e
+	}
+	return edges, nil
+}
+
+func edgeKey(e *Edge) (EdgeKey, error) {
+	tail, err := e.Tail()
+	if err != nil {
+		return EdgeKey{}, err
+	}
+	head, err := e.Head()
+	if err != nil {
+		return EdgeKey{}, err
+	}
+	var k EdgeKey
+	if k.Tail, err = tail.Name(); err != nil {
+		return EdgeKey{}, err
+	}
+	if k.Head, err = head.Name(); err != nil {
+		return EdgeKey{}, err
+	}
+	if k.Key, err = e.Name(); err != nil {
+		return EdgeKey{}, err
+	}
+	if isAnonymousEdgeName(k.Key) {
+		k.Key = ""
+	}
+	return k, nil
+}
+
+func subGraphsByName(g *Graph) (map[string]*Graph, error) {
+	subGraphs := map[string]*Graph{}
+	for sub, err := range g.SubGraphs() {
+		if err != nil {
+			return nil, err
+		}
+		name, err := sub.Name()
+		if err != nil {
+			return nil, err
+		}
+		subGraphs[name] = sub
+	}
+	return subGraphs, nil
+}
+
+const (
+	diffAddedColor   = "green"
+	diffRemovedColor = "red"
+)
+
+// DiffGraph returns a new graph combining a and b to visualize Diff(a, b).
+// Added nodes, edges and subgraphs are drawn in green, and removed ones in red with dashed lines.
+// The other items have the attributes of b.
+func DiffGraph(a, b *Graph) (*Graph, error) {
+	d, err := Diff(a, b)
+	if err != nil {
+		return nil, err
+	}
+	g, err := b.Clone()
+	if err != nil {
+		return nil, err
+	}
+	if err := g.markDiff(a, d); err != nil {
+		_ = g.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+func (g *Graph) markDiff(a *Graph, d *GraphDiff) error {
+	if err := g.Merge(a, MergeKeep); err != nil {
+		return err
+	}
+	for _, names := range []struct {
+		names   []string
+		removed bool
+	}{{d.AddedNodes, false}, {d.RemovedNodes, true}} {
+		for _, name := range names.names {
+			n, err := g.NodeByName(name)
+			if err != nil {
+				return err
+			}
+			if err := markDiffObject(n, names.removed); err != nil {
+				return err
+			}
+		}
+	}
+	edges, err := edgesByKey(g)
+	if err != nil {
+		return err
+	}
+	for _, keys := range []struct {
+		keys    []EdgeKey
+		removed bool
+	}{{d.AddedEdges, false}, {d.RemovedEdges, true}} {
+		for _, k := range keys.keys {
+			if err := markDiffObject(edges[k], keys.removed); err != nil {
+				return err
+			}
+		}
+	}
+	subGraphs, err := subGraphsByName(g)
+	if err != nil {
+		return err
+	}
+	for _, names := range []struct {
+		names   []string
+		removed bool
+	}{{d.AddedSubGraphs, false}, {d.RemovedSubGraphs, true}} {
+		for _, name := range names.names {
+			if err := markDiffObject(subGraphs[name], names.removed); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// diffObject is a graph, node or edge which can be marked by DiffGraph.
+type diffObject interface {
+	GetStr(name string) string
+	SafeSet(name, value, def string) error
+}
+
+func markDiffObject(obj diffObject, removed bool) error {
+	color := diffAddedColor
+	if removed {
+		color = diffRemovedColor
+	}
+	if err := obj.SafeSet(string(colorAttr), color, ""); err != nil {
+		return err
+	}
+	if err := obj.SafeSet(string(fontColorAttr), color, ""); err != nil {
+		return err
+	}
+	if !removed {
+		return nil
+	}
+	style := "dashed"
+	if cur := obj.GetStr(string(styleAttr)); cur != "" {
+		style = cur + ",dashed"
+	}
+	return obj.SafeSet(string(styleAttr), style, "")
+}
@@ -0,0 +1,400 @@
+package cgraph
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+var ErrGraphML = errors.New("invalid GraphML")
+
+const graphMLNamespace = "http://graphml.graphdrawing.org/xmlns"
+
+// The elements of GraphML. http://graphml.graphdrawing.org/specification.html
+type (
+	graphMLDocument struct {
+		XMLName xml.Name        `xml:"graphml"`
+		XMLNS   string          `xml:"xmlns,attr,omitempty"`
+		Keys    []*graphMLKey   `xml:"key"`
+		Graphs  []*graphMLGraph `xml:"graph"`
+	}
+	graphMLKey struct {
+		ID   string `xml:"id,attr"`
+		For  string `xml:"for,attr,omitempty"`
+		Name string `xml:"attr.name,attr,omitempty"`
+		Type string `xml:"attr.type,attr,omitempty"`
+		// Default is nil if the key has no default.
+		Default *graphMLValue `xml:"default"`
+	}
+	graphMLGraph struct {
+		ID          string         `xml:"id,attr,omitempty"`
+		EdgeDefault string         `xml:"edgedefault,attr,omitempty"`
+		Data        []*graphMLData `xml:"data"`
+		Nodes       []*graphMLNode `xml:"node"`
+		Edges       []*graphMLEdge `xml:"edge"`
+	}
+	graphMLNode struct {
+		ID    string         `xml:"id,attr"`
+		Data  []*graphMLData `xml:"data"`
+		Graph *graphMLGraph  `xml:"graph"`
+	}
+	graphMLEdge struct {
+		ID     string         `xml:"id,attr,omitempty"`
+		Source string         `xml:"source,attr"`
+		Target string         `xml:"target,attr"`
+		Data   []*graphMLData `xml:"data"`
+	}
+	graphMLData struct {
+		Key string `xml:"key,attr"`
+		graphMLValue
+	}
+	// graphMLValue is a value of an attribute. HTML is true if it is an HTML string such as label=<<b>bold</b>>.
+	// Inner is the raw content which is read to keep the child elements, and it is not written.
+	graphMLValue struct {
+		HTML  bool   `xml:"html,attr,omitempty"`
+		Value string `xml:",chardata"`
+		Inner string `xml:",innerxml"`
+	}
+)
+
+// ParseGraphML reads the first graph of the GraphML document.
+//
+// The keys are declared as the attributes named by attr.name ( or id if omitted ) with the defaults,
+// and data of undeclared keys are set as the attributes named by the key.
+// A node which has a nested graph becomes the subgraph named by the node id, so name it "cluster..." to draw it as a cluster.
+// Edges to such a node are connected to the first node of the subgraph with lhead or ltail and compound=true,
+// or to a node named by the id if the subgraph is empty.
+// Edge ids are set as the id attributes of the edges, and the values with html="true" as HTML strings. Data with child elements such as yFiles graphics are read as their inner XML,
+// and hyperedges and ports are ignored.
+func ParseGraphML(r io.Reader) (*Graph, error) {
+	var doc graphMLDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGraphML, err)
+	}
+	if len(doc.Graphs) == 0 {
+		return nil, fmt.Errorf("%w: no graph", ErrGraphML)
+	}
+	root := doc.Graphs[0]
+	desc := Directed
+	if root.EdgeDefault == "undirected" {
+		desc = UnDirected
+	}
+	g, err := Open(root.ID, desc, nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &graphMLParser{root: g, keys: map[string]*graphMLKey{}, groups: map[string]*Graph{}}
+	if err := p.parse(&doc, root); err != nil {
+		_ = g.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+type graphMLParser struct {
+	root *Graph
+	keys map[string]*graphMLKey
+	// groups are the subgraphs by the ids of the nodes which have nested graphs.
+	groups map[string]*Graph
+	// edges are the edges with the graphs which declare them. They are created after all nodes.
+	edges []*graphMLParsedEdge
+}
+
+type graphMLParsedEdge struct {
+	graph *Graph
+	edge  *graphMLEdge
+}
+
+func (p *graphMLParser) parse(doc *graphMLDocument, root *graphMLGraph) error {
+	for _, key := range doc.Keys {
+		if key.Name == "" {
+			key.Name = key.ID
+		}
+		p.keys[key.ID] = key
+		def := &graphMLValue{}
+		if key.Default != nil {
+			def = key.Default
+		}
+		for _, kind := range graphMLKinds(key.For) {
+			attr := p.root.Attr
+			if def.HTML {
+				attr = p.root.AttrHTML
+			}
+			if _, err := attr(int(kind), key.Name, def.text()); err != nil {
+				return err
+			}
+		}
+	}
+	if err := p.setData(p.root, root.Data); err != nil {
+		return err
+	}
+	if err := p.parseGraph(p.root, root); err != nil {
+		return err
+	}
+	for _, e := range p.edges {
+		if err := p.createEdge(e.graph, e.edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// graphMLKinds returns the kinds of the objects for the for attribute of a key.
+func graphMLKinds(domain string) []ObjectTag {
+	switch domain {
+	case "graph":
+		return []ObjectTag{GRAPH}
+	case "node":
+		return []ObjectTag{NODE}
+	case "edge":
+		return []ObjectTag{EDGE}
+	case "", "all":
+		return attributeTags
+	}
+	// port, hyperedge, endpoint and graphml are not supported.
+	return nil
+}
+
+func (p *graphMLParser) parseGraph(g *Graph, graph *graphMLGraph) error {
+	for _, node := range graph.Nodes {
+		if node.Graph != nil {
+			sub, err := g.CreateSubGraphByName(node.ID)
+			if err != nil {
+				return err
+			}
+			p.groups[node.ID] = sub
+			if err := p.setData(sub, node.Data); err != nil {
+				return err
+			}
+			if err := p.parseGraph(sub, node.Graph); err != nil {
+				return err
+			}
+			continue
+		}
+		n, err := g.CreateNodeByName(node.ID)
+		if err != nil {
+			return err
+		}
+		if err := p.setData(n, node.Data); err != nil {
+			return err
+		}
+	}
+	for _, e := range graph.Edges {
+		p.edges = append(p.edges, &graphMLParsedEdge{graph: g, edge: e})
+	}
+	return nil
+}
+
+func (p *graphMLParser) createEdge(g *Graph, edge *graphMLEdge) error {
+	tail, err := p.endpoint(edge.Source)
+	if err != nil {
+		return err
+	}
+	head, err := p.endpoint(edge.Target)
+	if err != nil {
+		return err
+	}
+	if tail == nil || head == nil {
+		return fmt.Errorf("%w: edge %s -> %s refers to an unknown node", ErrGraphML, edge.Source, edge.Target)
+	}
+	e, err := createAnonymousEdge(g, tail, head)
+	if err != nil {
+		return err
+	}
+	for _, end := range []struct{ attr, id string }{{"ltail", edge.Source}, {"lhead", edge.Target}} {
+		if p.groups[end.id] == nil {
+			continue
+		}
+		if err := e.SafeSet(end.attr, end.id, ""); err != nil {
+			return err
+		}
+		if err := p.root.SafeSet("compound", "true", ""); err != nil {
+			return err
+		}
+	}
+	if edge.ID != "" {
+		if err := e.SafeSet("id", edge.ID, ""); err != nil {
+			return err
+		}
+	}
+	return p.setData(e, edge.Data)
+}
+
+// endpoint returns the node of the id. A node which has a nested graph is represented by the first node of the subgraph,
+// and a node named by the id is created in the subgraph if it is empty.
+func (p *graphMLParser) endpoint(id string) (*Node, error) {
+	sub := p.groups[id]
+	if sub == nil {
+		return p.root.NodeByName(id)
+	}
+	n, err := sub.FirstNode()
+	if err != nil {
+		return nil, err
+	}
+	if n != nil {
+		return n, nil
+	}
+	return sub.CreateNodeByName(id)
+}
+
+// setData sets the data as the attributes declaring them if needed.
+func (p *graphMLParser) setData(obj interface {
+	SafeSet(name, value, def string) error
+	SafeSetHTML(name, value, def string) error
+}, data []*graphMLData) error {
+	for _, d := range data {
+		name := d.Key
+		if key := p.keys[d.Key]; key != nil {
+			name = key.Name
+		}
+		set := obj.SafeSet
+		if d.HTML {
+			set = obj.SafeSetHTML
+		}
+		if err := set(name, d.text(), ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGraphML writes the graph as a GraphML document.
+//
+// The declared attributes are written as the keys with the defaults, and the attribute values which differ
+// from the defaults as data. HTML strings are marked with html="true". Subgraphs are written as the nodes which have nested graphs,
+// and a node is written in the first subgraph containing it. Edges are written in the top-level graph
+// with the id attributes as the ids.
+func (g *Graph) WriteGraphML(w io.Writer) error {
+	wr := &graphMLWriter{keys: map[ObjectTag]map[string]string{}, defaults: map[ObjectTag]map[string]*graphMLValue{}, written: map[string]bool{}}
+	doc := &graphMLDocument{XMLNS: graphMLNamespace}
+	for _, kind := range attributeTags {
+		wr.defaults[kind] = map[string]*graphMLValue{}
+		wr.keys[kind] = map[string]string{}
+		for sym, err := range g.Attributes(kind) {
+			if err != nil {
+				return err
+			}
+			wr.defaults[kind][sym.Name()] = &graphMLValue{HTML: sym.IsHTML(), Value: sym.DefaultValue()}
+		}
+		for _, name := range sortedKeys(wr.defaults[kind]) {
+			id := fmt.Sprintf("%s_%s", objectTagName(kind), name)
+			wr.keys[kind][name] = id
+			doc.Keys = append(doc.Keys, &graphMLKey{ID: id, For: objectTagName(kind), Name: name, Type: "string", Default: wr.defaults[kind][name]})
+		}
+	}
+	directed, err := g.IsDirected()
+	if err != nil {
+		return err
+	}
+	wr.edgeDefault = "undirected"
+	if directed {
+		wr.edgeDefault = "directed"
+	}
+	name, err := g.Name()
+	if err != nil {
+		return err
+	}
+	root := &graphMLGraph{ID: name, EdgeDefault: wr.edgeDefault}
+	if err := wr.writeGraph(g, root); err != nil {
+		return err
+	}
+	for e, err := range g.Edges() {
+		if err != nil {
+			return err
+		}
+		k, err := edgeKey(e)
+		if err != nil {
+			return err
+		}
+		edge := &graphMLEdge{ID: e.GetStr("id"), Source: k.Tail, Target: k.Head}
+		for _, d := range wr.data(EDGE, e) {
+			// The id attribute is written as the id of the edge.
+			if d.Key != wr.keys[EDGE]["id"] || edge.ID == "" {
+				edge.Data = append(edge.Data, d)
+			}
+		}
+		root.Edges = append(root.Edges, edge)
+	}
+	doc.Graphs = []*graphMLGraph{root}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+type graphMLWriter struct {
+	edgeDefault string
+	// keys are the key ids by kind and attribute name.
+	keys     map[ObjectTag]map[string]string
+	defaults map[ObjectTag]map[string]*graphMLValue
+	// written are the names of the written nodes.
+	written map[string]bool
+}
+
+func (wr *graphMLWriter) writeGraph(g *Graph, graph *graphMLGraph) error {
+	sub, err := g.FirstSubGraph()
+	for ; sub != nil && err == nil; sub, err = sub.NextSubGraph() {
+		name, err := sub.Name()
+		if err != nil {
+			return err
+		}
+		nested := &graphMLGraph{ID: name + ":", EdgeDefault: wr.edgeDefault}
+		if err := wr.writeGraph(sub, nested); err != nil {
+			return err
+		}
+		graph.Nodes = append(graph.Nodes, &graphMLNode{ID: name, Data: wr.data(GRAPH, sub), Graph: nested})
+	}
+	if err != nil {
+		return err
+	}
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return err
+		}
+		if wr.written[name] {
+			continue
+		}
+		wr.written[name] = true
+		graph.Nodes = append(graph.Nodes, &graphMLNode{ID: name, Data: wr.data(NODE, n)})
+	}
+	return nil
+}
+
+// data returns the attribute values which differ from the defaults.
+func (wr *graphMLWriter) data(kind ObjectTag, obj attributeObject) []*graphMLData {
+	var data []*graphMLData
+	for _, name := range sortedKeys(wr.keys[kind]) {
+		v := graphMLValue{HTML: obj.IsHTML(name), Value: obj.GetStr(name)}
+		if v != *wr.defaults[kind][name] {
+			data = append(data, &graphMLData{Key: wr.keys[kind][name], graphMLValue: v})
+		}
+	}
+	return data
+}
+
+// text returns the value, or the inner XML if the value has child elements.
+func (v *graphMLValue) text() string {
+	dec := xml.NewDecoder(strings.NewReader(v.Inner))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return v.Value
+		}
+		if _, ok := tok.(xml.StartElement); ok {
+			return v.Inner
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package cgraph
+
+import (
+	"github.com/goccy/go-graphviz/cgraph/htmllabel"
+)
+
+// SetHTMLLabel sets the table as an HTML-like label.
+// The table is not validated. Use TrySetHTMLLabel to validate it.
+// https://graphviz.org/doc/info/shapes.html#html
+func (n *Node) SetHTMLLabel(v *htmllabel.Table) *Node {
+	_ = n.setHTMLLabel(v)
+	return n
+}
+
+// TrySetHTMLLabel validates the table and sets it as an HTML-like label.
+// It returns *AttributeError if the table does not follow the grammar of HTML-like labels.
+func (n *Node) TrySetHTMLLabel(v *htmllabel.Table) error {
+	if err := v.Validate(); err != nil {
+		return &AttributeError{Name: string(labelAttr), Value: v.String(), Type: string(lblStringValue), Err: err}
+	}
+	return n.setHTMLLabel(v)
+}
+
+func (n *Node) setHTMLLabel(v *htmllabel.Table) error {
+	// the value must be a string created by agstrdup_html to be interpreted as an HTML-like label.
+	s, err := n.Root().StrdupHTML(v.String())
+	if err != nil {
+		return err
+	}
+	return n.SafeSet(string(labelAttr), s, "\\N")
+}
@@ -0,0 +1,107 @@
+package cgraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/goccy/go-graphviz/internal/wasm"
+)
+
+// HTML strings are the attribute values such as label=<<b>bold</b>>, which are written in angle brackets instead of quotes in DOT.
+// Graphviz shares the strings of a root graph by their contents, so a value cannot be set as an HTML string
+// while the same string is used as a normal string in the root graph, and ErrHTMLConflict is returned.
+
+// ErrHTMLConflict is returned if an HTML string cannot be set because the same string is used as a normal string.
+var ErrHTMLConflict = errors.New("the string is already used as a normal string")
+
+// withHTML calls set while the value is referenced as an HTML string, so set stores it as the HTML string.
+// The reference is freed afterwards.
+func withHTML(g *Graph, value string, set func() error) (e error) {
+	ctx := context.Background()
+	ref, err := g.wasm.StrdupHTMLRef(ctx, value)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := g.wasm.StrFreeRef(ctx, ref); err != nil && e == nil {
+			e = err
+		}
+	}()
+	html, err := wasm.IsHTMLRef(ctx, ref)
+	if err != nil {
+		return err
+	}
+	if !html {
+		return fmt.Errorf("%w: %q", ErrHTMLConflict, value)
+	}
+	return set()
+}
+
+// IsHTML reports whether the default value of the attribute is an HTML string.
+func (s *Symbol) IsHTML() bool {
+	v, _ := s.wasm.IsHTMLDefval(context.Background())
+	return v
+}
+
+// AttrHTML declares the attribute with the default value as an HTML string like Attr.
+func (g *Graph) AttrHTML(kind int, name, value string) (*Symbol, error) {
+	var sym *Symbol
+	err := withHTML(g, value, func() error {
+		var err error
+		sym, err = g.Attr(kind, name, value)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sym, nil
+}
+
+// IsHTML reports whether the value of the attribute is an HTML string.
+func (g *Graph) IsHTML(name string) bool {
+	v, _ := wasm.IsHTMLStr(context.Background(), g.wasm, name)
+	return v
+}
+
+// SetHTML sets the value of the attribute as an HTML string like Set.
+func (g *Graph) SetHTML(name, value string) error {
+	return withHTML(g, value, func() error { return g.Set(name, value) })
+}
+
+// SafeSetHTML sets the value of the attribute as an HTML string like SafeSet.
+func (g *Graph) SafeSetHTML(name, value, def string) error {
+	return withHTML(g, value, func() error { return g.SafeSet(name, value, def) })
+}
+
+// IsHTML reports whether the value of the attribute is an HTML string.
+func (n *Node) IsHTML(name string) bool {
+	v, _ := wasm.IsHTMLStr(context.Background(), n.wasm, name)
+	return v
+}
+
+// SetHTML sets the value of the attribute as an HTML string like Set.
+func (n *Node) SetHTML(name, value string) error {
+	return withHTML(n.Root(), value, func() error { return n.Set(name, value) })
+}
+
+// SafeSetHTML sets the value of the attribute as an HTML string like SafeSet.
+func (n *Node) SafeSetHTML(name, value, def string) error {
+	return withHTML(n.Root(), value, func() error { return n.SafeSet(name, value, def) })
+}
+
+// IsHTML reports whether the value of the attribute is an HTML string.
+func (e *Edge) IsHTML(name string) bool {
+	v, _ := wasm.IsHTMLStr(context.Background(), e.wasm, name)
+	return v
+}
+
+// SetHTML sets the value of the attribute as an HTML string like Set.
+func (e *Edge) SetHTML(name, value string) error {
+	return withHTML(e.Node().Root(), value, func() error { return e.Set(name, value) })
+}
+
+// SafeSetHTML sets the value of the attribute as an HTML string like SafeSet.
+func (e *Edge) SafeSetHTML(name, value, def string) error {
+	return withHTML(e.Node().Root(), value, func() error { return e.SafeSet(name, value, def) })
+}
@@ -0,0 +1,378 @@
+// Package htmllabel builds, validates and parses HTML-like labels of Graphviz.
+// The grammar is described in https://graphviz.org/doc/info/shapes.html#html .
+package htmllabel
+
+import (
+	"html"
+	"strings"
+)
+
+// Element is a part of a label: Text, *Break, *Font, *Format, *Table or *Image.
+type Element interface {
+	write(b *strings.Builder)
+}
+
+// Label is the content of an HTML-like label. It is either text or a single table optionally wrapped by font elements.
+type Label []Element
+
+// String returns the label in the HTML-like format without the enclosing '<' and '>'.
+func (l Label) String() string {
+	var b strings.Builder
+	writeElements(&b, l)
+	return b.String()
+}
+
+// Table returns the table of the label. It returns nil if the label is not a table.
+// Font elements enclosing the table are skipped.
+func (l Label) Table() *Table {
+	for len(l) == 1 {
+		switch e := l[0].(type) {
+		case *Table:
+			return e
+		case *Font:
+			l = e.Content
+		case *Format:
+			l = e.Content
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+// Attribute is an attribute of an element.
+type Attribute struct {
+	Name  string
+	Value string
+}
+
+// Attributes are the attributes of an element in the order they are written.
+type Attributes []Attribute
+
+// Get returns the value of the attribute. Names are compared ignoring case.
+func (a Attributes) Get(name string) (string, bool) {
+	for _, attr := range a {
+		if strings.EqualFold(attr.Name, name) {
+			return attr.Value, true
+		}
+	}
+	return "", false
+}
+
+func (a *Attributes) set(name, value string) {
+	name = strings.ToUpper(name)
+	for i, attr := range *a {
+		if strings.EqualFold(attr.Name, name) {
+			(*a)[i].Value = value
+			return
+		}
+	}
+	*a = append(*a, Attribute{Name: name, Value: value})
+}
+
+func (a Attributes) write(b *strings.Builder) {
+	for _, attr := range a {
+		b.WriteByte(' ')
+		b.WriteString(strings.ToUpper(attr.Name))
+		b.WriteString(`="`)
+		b.WriteString(html.EscapeString(attr.Value))
+		b.WriteByte('"')
+	}
+}
+
+// Table is a <TABLE> element.
+type Table struct {
+	Attrs Attributes
+	Rows  []*Row
+}
+
+// NewTable creates a table of the rows.
+func NewTable(rows ...*Row) *Table {
+	return &Table{Rows: rows}
+}
+
+// String returns the table in the HTML-like format without the enclosing '<' and '>'.
+func (t *Table) String() string {
+	return Label{t}.String()
+}
+
+// Set sets the attribute. Use Validate to check that the table accepts it.
+func (t *Table) Set(name, value string) *Table {
+	t.Attrs.set(name, value)
+	return t
+}
+
+// AddRow appends the row to the table.
+func (t *Table) AddRow(r *Row) *Table {
+	t.Rows = append(t.Rows, r)
+	return t
+}
+
+// Border sets the width of the border around the table.
+func (t *Table) Border(v int) *Table { return t.Set("BORDER", itoa(v)) }
+
+// CellBorder sets the width of the border of the cells.
+func (t *Table) CellBorder(v int) *Table { return t.Set("CELLBORDER", itoa(v)) }
+
+// CellSpacing sets the space between the cells.
+func (t *Table) CellSpacing(v int) *Table { return t.Set("CELLSPACING", itoa(v)) }
+
+// CellPadding sets the space between the content and the border of the cells.
+func (t *Table) CellPadding(v int) *Table { return t.Set("CELLPADDING", itoa(v)) }
+
+// BGColor sets the background color.
+func (t *Table) BGColor(v string) *Table { return t.Set("BGCOLOR", v) }
+
+// Color sets the color of the border.
+func (t *Table) Color(v string) *Table { return t.Set("COLOR", v) }
+
+// Port sets the port name of the table.
+func (t *Table) Port(v string) *Table { return t.Set("PORT", v) }
+
+func (t *Table) write(b *strings.Builder) {
+	b.WriteString("<TABLE")
+	t.Attrs.write(b)
+	b.WriteByte('>')
+	for _, r := range t.Rows {
+		r.write(b)
+	}
+	b.WriteString("</TABLE>")
+}
+
+// Row is a <TR> element.
+type Row struct {
+	// HR reports whether the row is preceded by a horizontal rule <HR/>.
+	HR    bool
+	Cells []*Cell
+}
+
+// NewRow creates a row of the cells.
+func NewRow(cells ...*Cell) *Row {
+	return &Row{Cells: cells}
+}
+
+// AddCell appends the cell to the row.
+func (r *Row) AddCell(c *Cell) *Row {
+	r.Cells = append(r.Cells, c)
+	return r
+}
+
+// WithHR puts a horizontal rule before the row.
+func (r *Row) WithHR() *Row {
+	r.HR = true
+	return r
+}
+
+func (r *Row) write(b *strings.Builder) {
+	if r.HR {
+		b.WriteString("<HR/>")
+	}
+	b.WriteString("<TR>")
+	for _, c := range r.Cells {
+		c.write(b)
+	}
+	b.WriteString("</TR>")
+}
+
+// Cell is a <TD> element. The content is text, a single table or a single image.
+type Cell struct {
+	// VR reports whether the cell is preceded by a vertical rule <VR/>.
+	VR      bool
+	Attrs   Attributes
+	Content []Element
+}
+
+// NewCell creates a cell of the content.
+func NewCell(content ...Element) *Cell {
+	return &Cell{Content: content}
+}
+
+// Set sets the attribute. Use Validate to check that the cell accepts it.
+func (c *Cell) Set(name, value string) *Cell {
+	c.Attrs.set(name, value)
+	return c
+}
+
+// WithVR puts a vertical rule before the cell.
+func (c *Cell) WithVR() *Cell {
+	c.VR = true
+	return c
+}
+
+// Port sets the port name of the cell which can be referred by edges. e.g. "node:port".
+func (c *Cell) Port(v string) *Cell { return c.Set("PORT", v) }
+
+// ColSpan sets the number of columns the cell spans.
+func (c *Cell) ColSpan(v int) *Cell { return c.Set("COLSPAN", itoa(v)) }
+
+// RowSpan sets the number of rows the cell spans.
+func (c *Cell) RowSpan(v int) *Cell { return c.Set("ROWSPAN", itoa(v)) }
+
+// Border sets the width of the border of the cell.
+func (c *Cell) Border(v int) *Cell { return c.Set("BORDER", itoa(v)) }
+
+// BGColor sets the background color.
+func (c *Cell) BGColor(v string) *Cell { return c.Set("BGCOLOR", v) }
+
+// Align sets the horizontal alignment: "CENTER", "LEFT", "RIGHT" or "TEXT".
+func (c *Cell) Align(v string) *Cell { return c.Set("ALIGN", v) }
+
+func (c *Cell) write(b *strings.Builder) {
+	if c.VR {
+		b.WriteString("<VR/>")
+	}
+	b.WriteString("<TD")
+	c.Attrs.write(b)
+	b.WriteByte('>')
+	writeElements(b, c.Content)
+	b.WriteString("</TD>")
+}
+
+// Text is a text. It is escaped when written.
+type Text string
+
+func (t Text) write(b *strings.Builder) {
+	b.WriteString(html.EscapeString(string(t)))
+}
+
+// Break is a <BR/> element.
+type Break struct {
+	Attrs Attributes
+}
+
+// NewBreak creates a line break.
+func NewBreak() *Break {
+	return &Break{}
+}
+
+// Align sets the alignment of the line preceding the break: "CENTER", "LEFT" or "RIGHT".
+func (br *Break) Align(v string) *Break {
+	br.Attrs.set("ALIGN", v)
+	return br
+}
+
+func (br *Break) write(b *strings.Builder) {
+	b.WriteString("<BR")
+	br.Attrs.write(b)
+	b.WriteString("/>")
+}
+
+// Font is a <FONT> element.
+type Font struct {
+	Attrs   Attributes
+	Content []Element
+}
+
+// NewFont creates a font element of the content.
+func NewFont(content ...Element) *Font {
+	return &Font{Content: content}
+}
+
+// Set sets the attribute. Use Validate to check that the font accepts it.
+func (f *Font) Set(name, value string) *Font {
+	f.Attrs.set(name, value)
+	return f
+}
+
+// Color sets the color of the text.
+func (f *Font) Color(v string) *Font { return f.Set("COLOR", v) }
+
+// Face sets the font name.
+func (f *Font) Face(v string) *Font { return f.Set("FACE", v) }
+
+// PointSize sets the font size.
+func (f *Font) PointSize(v float64) *Font { return f.Set("POINT-SIZE", ftoa(v)) }
+
+func (f *Font) write(b *strings.Builder) {
+	b.WriteString("<FONT")
+	f.Attrs.write(b)
+	b.WriteByte('>')
+	writeElements(b, f.Content)
+	b.WriteString("</FONT>")
+}
+
+// FormatTag is the tag name of a text style element.
+type FormatTag string
+
+const (
+	Bold          FormatTag = "B"
+	Italic        FormatTag = "I"
+	Underline     FormatTag = "U"
+	Overline      FormatTag = "O"
+	Subscript     FormatTag = "SUB"
+	Superscript   FormatTag = "SUP"
+	Strikethrough FormatTag = "S"
+)
+
+// Format is a text style element such as <B> and <I>.
+type Format struct {
+	Tag     FormatTag
+	Content []Element
+}
+
+// NewFormat creates a text style element of the content.
+func NewFormat(tag FormatTag, content ...Element) *Format {
+	return &Format{Tag: tag, Content: content}
+}
+
+func (f *Format) write(b *strings.Builder) {
+	b.WriteString("<" + string(f.Tag) + ">")
+	writeElements(b, f.Content)
+	b.WriteString("</" + string(f.Tag) + ">")
+}
+
+// Image is an <IMG/> element.
+type Image struct {
+	Attrs Attributes
+}
+
+// NewImage creates an image of the file.
+func NewImage(src string) *Image {
+	img := &Image{}
+	img.Attrs.set("SRC", src)
+	return img
+}
+
+// Scale sets how the image fills the cell: "FALSE", "TRUE", "WIDTH", "HEIGHT" or "BOTH".
+func (img *Image) Scale(v string) *Image {
+	img.Attrs.set("SCALE", v)
+	return img
+}
+
+func (img *Image) write(b *strings.Builder) {
+	b.WriteString("<IMG")
+	img.Attrs.write(b)
+	b.WriteString("/>")
+}
+
+func writeElements(b *strings.Builder, elems []Element) {
+	for _, e := range elems {
+		e.write(b)
+	}
+}
+
+// Ports returns the PORT attributes of the tables and cells in the order they appear.
+func (l Label) Ports() []string {
+	var ports []string
+	for _, e := range l {
+		switch e := e.(type) {
+		case *Table:
+			if port, ok := e.Attrs.Get("PORT"); ok {
+				ports = append(ports, port)
+			}
+			for _, r := range e.Rows {
+				for _, c := range r.Cells {
+					if port, ok := c.Attrs.Get("PORT"); ok {
+						ports = append(ports, port)
+					}
+					ports = append(ports, Label(c.Content).Ports()...)
+				}
+			}
+		case *Font:
+			ports = append(ports, Label(e.Content).Ports()...)
+		case *Format:
+			ports = append(ports, Label(e.Content).Ports()...)
+		}
+	}
+	return ports
+}
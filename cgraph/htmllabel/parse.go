@@ -0,0 +1,207 @@
+package htmllabel
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// rootTag encloses the label to parse it as a XML document.
+const rootTag = "htmllabel"
+
+// xmlElement is an element of the label before it is converted to the AST.
+type xmlElement struct {
+	name     string
+	attrs    Attributes
+	children []any // string or *xmlElement
+}
+
+// Parse parses an HTML-like label without the enclosing '<' and '>' such as "<B>a</B>" and validates it.
+// Tag and attribute names are case insensitive as in Graphviz, and they are upper-cased in the AST.
+func Parse(s string) (Label, error) {
+	root, err := parseXML(s)
+	if err != nil {
+		return nil, err
+	}
+	l, err := toElements(root.children)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.Validate(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// ParseTable parses an HTML-like label which is a table such as "<TABLE><TR><TD>a</TD></TR></TABLE>".
+// Font elements enclosing the table are discarded.
+func ParseTable(s string) (*Table, error) {
+	l, err := Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	t := l.Table()
+	if t == nil {
+		return nil, fmt.Errorf("%w: label is not a table", ErrInvalidContent)
+	}
+	return t, nil
+}
+
+func parseXML(s string) (*xmlElement, error) {
+	d := xml.NewDecoder(strings.NewReader("<" + rootTag + ">" + s + "</" + rootTag + ">"))
+	d.Entity = xml.HTMLEntity
+	root := &xmlElement{name: rootTag}
+	var stack []*xmlElement
+	for {
+		tok, err := d.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("htmllabel: %w", err)
+		}
+		if len(stack) == 0 {
+			// the first token is the start of rootTag.
+			stack = append(stack, root)
+			continue
+		}
+		cur := stack[len(stack)-1]
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			e := &xmlElement{name: strings.ToUpper(tok.Name.Local)}
+			for _, attr := range tok.Attr {
+				e.attrs.set(attr.Name.Local, attr.Value)
+			}
+			cur.children = append(cur.children, e)
+			stack = append(stack, e)
+		case xml.EndElement:
+			stack = stack[:len(stack)-1]
+		case xml.CharData:
+			cur.children = append(cur.children, string(tok))
+		}
+	}
+	return root, nil
+}
+
+func toElements(children []any) (Label, error) {
+	// whitespaces around a table or an image are not a part of the content.
+	skipSpaces := false
+	for _, c := range children {
+		if e, ok := c.(*xmlElement); ok && (e.name == "TABLE" || e.name == "IMG") {
+			skipSpaces = true
+		}
+	}
+	var ret Label
+	for _, c := range children {
+		e, ok := c.(*xmlElement)
+		if !ok {
+			if skipSpaces && strings.TrimSpace(c.(string)) == "" {
+				continue
+			}
+			ret = append(ret, Text(c.(string)))
+			continue
+		}
+		elem, err := toElement(e)
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, elem)
+	}
+	return ret, nil
+}
+
+func toElement(e *xmlElement) (Element, error) {
+	switch e.name {
+	case "TABLE":
+		return toTable(e)
+	case "IMG":
+		if len(e.children) != 0 {
+			return nil, fmt.Errorf("%w: <IMG> has content", ErrInvalidContent)
+		}
+		return &Image{Attrs: e.attrs}, nil
+	case "BR":
+		if len(e.children) != 0 {
+			return nil, fmt.Errorf("%w: <BR> has content", ErrInvalidContent)
+		}
+		return &Break{Attrs: e.attrs}, nil
+	case "FONT":
+		content, err := toElements(e.children)
+		if err != nil {
+			return nil, err
+		}
+		return &Font{Attrs: e.attrs, Content: content}, nil
+	case string(Bold), string(Italic), string(Underline), string(Overline),
+		string(Subscript), string(Superscript), string(Strikethrough):
+		if len(e.attrs) != 0 {
+			return nil, fmt.Errorf("%w: %s of <%s>", ErrUnknownAttribute, e.attrs[0].Name, e.name)
+		}
+		content, err := toElements(e.children)
+		if err != nil {
+			return nil, err
+		}
+		return &Format{Tag: FormatTag(e.name), Content: content}, nil
+	}
+	return nil, fmt.Errorf("%w: unexpected element <%s>", ErrInvalidContent, e.name)
+}
+
+func toTable(e *xmlElement) (*Table, error) {
+	t := &Table{Attrs: e.attrs}
+	hr := false
+	for _, c := range e.children {
+		child, ok := c.(*xmlElement)
+		if !ok {
+			if strings.TrimSpace(c.(string)) != "" {
+				return nil, fmt.Errorf("%w: text %q in <TABLE>", ErrInvalidContent, c)
+			}
+			continue
+		}
+		switch child.name {
+		case "HR":
+			hr = true
+		case "TR":
+			r, err := toRow(child)
+			if err != nil {
+				return nil, err
+			}
+			r.HR = hr
+			hr = false
+			t.Rows = append(t.Rows, r)
+		default:
+			return nil, fmt.Errorf("%w: unexpected element <%s> in <TABLE>", ErrInvalidContent, child.name)
+		}
+	}
+	return t, nil
+}
+
+func toRow(e *xmlElement) (*Row, error) {
+	if len(e.attrs) != 0 {
+		return nil, fmt.Errorf("%w: %s of <TR>", ErrUnknownAttribute, e.attrs[0].Name)
+	}
+	r := &Row{}
+	vr := false
+	for _, c := range e.children {
+		child, ok := c.(*xmlElement)
+		if !ok {
+			if strings.TrimSpace(c.(string)) != "" {
+				return nil, fmt.Errorf("%w: text %q in <TR>", ErrInvalidContent, c)
+			}
+			continue
+		}
+		switch child.name {
+		case "VR":
+			vr = true
+		case "TD":
+			content, err := toElements(child.children)
+			if err != nil {
+				return nil, err
+			}
+			r.Cells = append(r.Cells, &Cell{VR: vr, Attrs: child.attrs, Content: content})
+			vr = false
+		default:
+			return nil, fmt.Errorf("%w: unexpected element <%s> in <TR>", ErrInvalidContent, child.name)
+		}
+	}
+	return r, nil
+}
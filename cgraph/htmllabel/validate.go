@@ -0,0 +1,282 @@
+package htmllabel
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrUnknownAttribute = errors.New("htmllabel: unknown attribute")
+	ErrInvalidValue     = errors.New("htmllabel: invalid attribute value")
+	ErrInvalidContent   = errors.New("htmllabel: invalid content")
+)
+
+// attributeValidator validates an attribute value.
+type attributeValidator func(string) error
+
+func intRange(minimum, maximum int) attributeValidator {
+	return func(v string) error {
+		i, err := strconv.Atoi(v)
+		if err != nil {
+			return fmt.Errorf("%q is not an integer", v)
+		}
+		if i < minimum || i > maximum {
+			return fmt.Errorf("%d is out of range [%d, %d]", i, minimum, maximum)
+		}
+		return nil
+	}
+}
+
+func oneOf(values ...string) attributeValidator {
+	return func(v string) error {
+		for _, value := range values {
+			if strings.EqualFold(v, value) {
+				return nil
+			}
+		}
+		return fmt.Errorf("%q is not one of %s", v, strings.Join(values, ", "))
+	}
+}
+
+// listOf validates a list of values separated by comma.
+func listOf(values ...string) attributeValidator {
+	validate := oneOf(values...)
+	return func(v string) error {
+		for _, s := range strings.Split(v, ",") {
+			if err := validate(strings.TrimSpace(s)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
+
+func anyValue(string) error { return nil }
+
+func positiveDouble(v string) error {
+	d, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return fmt.Errorf("%q is not a number", v)
+	}
+	if d <= 0 {
+		return fmt.Errorf("%g is not positive", d)
+	}
+	return nil
+}
+
+func sides(v string) error {
+	if v == "" {
+		return fmt.Errorf("empty sides")
+	}
+	for _, c := range strings.ToUpper(v) {
+		if !strings.ContainsRune("LTRB", c) {
+			return fmt.Errorf("%q is not a combination of L, T, R and B", v)
+		}
+	}
+	return nil
+}
+
+func rules(v string) error {
+	if v != "*" {
+		return fmt.Errorf("%q is not *", v)
+	}
+	return nil
+}
+
+// cellAttributes are the attributes shared by <TABLE> and <TD>.
+var cellAttributes = map[string]attributeValidator{
+	"ALIGN":         oneOf("CENTER", "LEFT", "RIGHT"),
+	"BALIGN":        oneOf("CENTER", "LEFT", "RIGHT"),
+	"BGCOLOR":       anyValue,
+	"BORDER":        intRange(0, 127),
+	"CELLPADDING":   intRange(0, 255),
+	"CELLSPACING":   intRange(0, 127),
+	"COLOR":         anyValue,
+	"FIXEDSIZE":     oneOf("FALSE", "TRUE"),
+	"GRADIENTANGLE": intRange(0, 360),
+	"HEIGHT":        intRange(0, 65535),
+	"HREF":          anyValue,
+	"ID":            anyValue,
+	"PORT":          anyValue,
+	"SIDES":         sides,
+	"TARGET":        anyValue,
+	"TITLE":         anyValue,
+	"TOOLTIP":       anyValue,
+	"VALIGN":        oneOf("MIDDLE", "BOTTOM", "TOP"),
+	"WIDTH":         intRange(0, 65535),
+}
+
+var tableAttributes = merge(cellAttributes, map[string]attributeValidator{
+	"CELLBORDER": intRange(0, 127),
+	"COLUMNS":    rules,
+	"ROWS":       rules,
+	"STYLE":      listOf("ROUNDED", "RADIAL", "INVIS", "INVISIBLE", "DOTTED", "DASHED", "SOLID"),
+})
+
+var tdAttributes = merge(cellAttributes, map[string]attributeValidator{
+	"ALIGN":   oneOf("CENTER", "LEFT", "RIGHT", "TEXT"),
+	"COLSPAN": intRange(1, 65535),
+	"ROWSPAN": intRange(1, 65535),
+	"STYLE":   listOf("RADIAL", "INVIS", "INVISIBLE", "DOTTED", "DASHED", "SOLID"),
+})
+
+var fontAttributes = map[string]attributeValidator{
+	"COLOR":      anyValue,
+	"FACE":       anyValue,
+	"POINT-SIZE": positiveDouble,
+}
+
+var brAttributes = map[string]attributeValidator{
+	"ALIGN": oneOf("CENTER", "LEFT", "RIGHT"),
+}
+
+var imgAttributes = map[string]attributeValidator{
+	"SCALE": oneOf("FALSE", "TRUE", "WIDTH", "HEIGHT", "BOTH"),
+	"SRC":   anyValue,
+}
+
+func merge(base, extra map[string]attributeValidator) map[string]attributeValidator {
+	ret := make(map[string]attributeValidator, len(base)+len(extra))
+	for k, v := range base {
+		ret[k] = v
+	}
+	for k, v := range extra {
+		ret[k] = v
+	}
+	return ret
+}
+
+func validateAttributes(tag string, attrs Attributes, allowed map[string]attributeValidator) error {
+	for _, attr := range attrs {
+		validate, exists := allowed[strings.ToUpper(attr.Name)]
+		if !exists {
+			return fmt.Errorf("%w: %s of <%s>", ErrUnknownAttribute, attr.Name, tag)
+		}
+		if err := validate(attr.Value); err != nil {
+			return fmt.Errorf("%w: %s of <%s>: %s", ErrInvalidValue, attr.Name, tag, err)
+		}
+	}
+	return nil
+}
+
+// Validate reports whether the label follows the grammar of HTML-like labels
+// and its elements have only the allowed attributes.
+// Color values are not validated.
+func (l Label) Validate() error {
+	if l.Table() != nil {
+		return validateFontTable(l)
+	}
+	return validateText(l)
+}
+
+// Validate reports whether the table follows the grammar of HTML-like labels
+// and its elements have only the allowed attributes.
+func (t *Table) Validate() error {
+	if err := validateAttributes("TABLE", t.Attrs, tableAttributes); err != nil {
+		return err
+	}
+	if len(t.Rows) == 0 {
+		return fmt.Errorf("%w: <TABLE> has no rows", ErrInvalidContent)
+	}
+	for i, r := range t.Rows {
+		if r == nil {
+			return fmt.Errorf("%w: <TABLE> has a nil row", ErrInvalidContent)
+		}
+		if i == 0 && r.HR {
+			return fmt.Errorf("%w: <HR/> before the first row", ErrInvalidContent)
+		}
+		if len(r.Cells) == 0 {
+			return fmt.Errorf("%w: <TR> has no cells", ErrInvalidContent)
+		}
+		for j, c := range r.Cells {
+			if c == nil {
+				return fmt.Errorf("%w: <TR> has a nil cell", ErrInvalidContent)
+			}
+			if j == 0 && c.VR {
+				return fmt.Errorf("%w: <VR/> before the first cell", ErrInvalidContent)
+			}
+			if err := c.validate(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Cell) validate() error {
+	if err := validateAttributes("TD", c.Attrs, tdAttributes); err != nil {
+		return err
+	}
+	if len(c.Content) == 1 {
+		if img, ok := c.Content[0].(*Image); ok {
+			return validateAttributes("IMG", img.Attrs, imgAttributes)
+		}
+	}
+	return Label(c.Content).Validate()
+}
+
+// validateFontTable validates a table optionally wrapped by font elements.
+func validateFontTable(l Label) error {
+	switch e := l[0].(type) {
+	case *Table:
+		return e.Validate()
+	case *Font:
+		if err := validateAttributes("FONT", e.Attrs, fontAttributes); err != nil {
+			return err
+		}
+		return validateFontTable(e.Content)
+	case *Format:
+		switch e.Tag {
+		case Bold, Italic, Underline, Overline:
+		default:
+			return fmt.Errorf("%w: <%s> cannot contain <TABLE>", ErrInvalidContent, e.Tag)
+		}
+		return validateFontTable(e.Content)
+	}
+	return nil
+}
+
+func validateText(l Label) error {
+	for _, e := range l {
+		switch e := e.(type) {
+		case Text:
+		case *Break:
+			if err := validateAttributes("BR", e.Attrs, brAttributes); err != nil {
+				return err
+			}
+		case *Font:
+			if err := validateAttributes("FONT", e.Attrs, fontAttributes); err != nil {
+				return err
+			}
+			if err := validateText(e.Content); err != nil {
+				return err
+			}
+		case *Format:
+			switch e.Tag {
+			case Bold, Italic, Underline, Overline, Subscript, Superscript, Strikethrough:
+			default:
+				return fmt.Errorf("%w: unknown element <%s>", ErrInvalidContent, e.Tag)
+			}
+			if err := validateText(e.Content); err != nil {
+				return err
+			}
+		case *Table:
+			return fmt.Errorf("%w: <TABLE> mixed with text", ErrInvalidContent)
+		case *Image:
+			return fmt.Errorf("%w: <IMG/> outside of <TD>", ErrInvalidContent)
+		default:
+			return fmt.Errorf("%w: unexpected element %T", ErrInvalidContent, e)
+		}
+	}
+	return nil
+}
+
+func itoa(v int) string {
+	return strconv.Itoa(v)
+}
+
+func ftoa(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
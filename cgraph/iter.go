@@ -0,0 +1,111 @@
+package cgraph
+
+import (
+	"iter"
+)
+
+// Nodes returns an iterator over the nodes of the graph.
+// For a subgraph, nodes belonging to nested subgraphs are included as well.
+// If an error occurs, it is yielded with a nil node and the iteration stops.
+func (g *Graph) Nodes() iter.Seq2[*Node, error] {
+	return func(yield func(*Node, error) bool) {
+		n, err := g.FirstNode()
+		for ; n != nil && err == nil; n, err = g.NextNode(n) {
+			if !yield(n, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// Edges returns an iterator over the edges of the graph.
+// Every edge is yielded exactly once, also for undirected graphs.
+func (g *Graph) Edges() iter.Seq2[*Edge, error] {
+	return func(yield func(*Edge, error) bool) {
+		for n, err := range g.Nodes() {
+			if err != nil {
+				yield(nil, err)
+				return
+			}
+			for e, err := range g.OutEdges(n) {
+				if !yield(e, err) || err != nil {
+					return
+				}
+			}
+		}
+	}
+}
+
+// OutEdges returns an iterator over the edges whose tail is n.
+func (g *Graph) OutEdges(n *Node) iter.Seq2[*Edge, error] {
+	return func(yield func(*Edge, error) bool) {
+		e, err := g.FirstOut(n)
+		for ; e != nil && err == nil; e, err = g.NextOut(e) {
+			if !yield(e, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// InEdges returns an iterator over the edges whose head is n.
+func (g *Graph) InEdges(n *Node) iter.Seq2[*Edge, error] {
+	return func(yield func(*Edge, error) bool) {
+		e, err := g.FirstIn(n)
+		for ; e != nil && err == nil; e, err = g.NextIn(e) {
+			if !yield(e, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+// SubGraphs returns an iterator over all subgraphs of the graph, including nested ones.
+// Subgraphs are traversed depth-first, so a subgraph is always yielded before its children.
+func (g *Graph) SubGraphs() iter.Seq2[*Graph, error] {
+	return func(yield func(*Graph, error) bool) {
+		g.walkSubGraphs(yield)
+	}
+}
+
+func (g *Graph) walkSubGraphs(yield func(*Graph, error) bool) bool {
+	sub, err := g.FirstSubGraph()
+	// NextSubGraph returns the next sibling of the receiver.
+	for ; sub != nil && err == nil; sub, err = sub.NextSubGraph() {
+		if !yield(sub, nil) {
+			return false
+		}
+		if !sub.walkSubGraphs(yield) {
+			return false
+		}
+	}
+	if err != nil {
+		yield(nil, err)
+		return false
+	}
+	return true
+}
+
+// Attributes returns an iterator over the attributes declared for kind ( GRAPH, NODE or EDGE ).
+func (g *Graph) Attributes(kind ObjectTag) iter.Seq2[*Symbol, error] {
+	return func(yield func(*Symbol, error) bool) {
+		sym, err := g.NextAttr(int(kind), nil)
+		for ; sym != nil && err == nil; sym, err = g.NextAttr(int(kind), sym) {
+			if !yield(sym, nil) {
+				return
+			}
+		}
+		if err != nil {
+			yield(nil, err)
+		}
+	}
+}
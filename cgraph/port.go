@@ -0,0 +1,181 @@
+package cgraph
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph/htmllabel"
+)
+
+var (
+	ErrUnknownPort         = errors.New("unknown port")
+	ErrUnknownCompassPoint = errors.New("unknown compass point")
+)
+
+// CompassPoint is a compass point of portPos type.
+// https://graphviz.org/docs/attr-types/portPos/
+type CompassPoint string
+
+const (
+	NorthCompass     CompassPoint = "n"
+	NorthEastCompass CompassPoint = "ne"
+	EastCompass      CompassPoint = "e"
+	SouthEastCompass CompassPoint = "se"
+	SouthCompass     CompassPoint = "s"
+	SouthWestCompass CompassPoint = "sw"
+	WestCompass      CompassPoint = "w"
+	NorthWestCompass CompassPoint = "nw"
+	CenterCompass    CompassPoint = "c"
+	// AnyCompass lets the layout engine choose the side of the node.
+	AnyCompass CompassPoint = "_"
+)
+
+func (c CompassPoint) valid() bool {
+	switch c {
+	case NorthCompass, NorthEastCompass, EastCompass, SouthEastCompass, SouthCompass,
+		SouthWestCompass, WestCompass, NorthWestCompass, CenterCompass, AnyCompass:
+		return true
+	}
+	return false
+}
+
+// Port is an end point of an edge written as "node:port:compass" in DOT.
+type Port struct {
+	Node *Node
+	// Name is the name of the port defined by a record label or an HTML-like label. It is empty if not specified.
+	Name string
+	// Compass is the compass point of the node or the port. It is empty if not specified.
+	Compass CompassPoint
+}
+
+// String returns the value of headport or tailport attribute. e.g. "f0:n"
+func (p Port) String() string {
+	if p.Name != "" && p.Compass != "" {
+		return p.Name + ":" + string(p.Compass)
+	}
+	if p.Name != "" {
+		return p.Name
+	}
+	return string(p.Compass)
+}
+
+// validate reports whether the node has the port and the compass point is valid.
+func (p Port) validate() error {
+	if p.Node == nil {
+		return fmt.Errorf("node of the port is nil")
+	}
+	if p.Compass != "" && !p.Compass.valid() {
+		return fmt.Errorf("%w: %q", ErrUnknownCompassPoint, p.Compass)
+	}
+	if p.Name == "" {
+		return nil
+	}
+	ports, err := p.Node.Ports()
+	if err != nil {
+		return err
+	}
+	for _, port := range ports {
+		if port == p.Name {
+			return nil
+		}
+	}
+	name, _ := p.Node.Name()
+	return fmt.Errorf("%w: %s of node %s", ErrUnknownPort, p.Name, name)
+}
+
+// ParsePort parses a value of headport or tailport attribute of the node such as "f0:n".
+// A single name is a compass point if it is a compass point and the node does not have the port of the name.
+func ParsePort(n *Node, v string) (Port, error) {
+	if v == "" {
+		return Port{Node: n}, nil
+	}
+	if name, compass, found := strings.Cut(v, ":"); found {
+		p := Port{Node: n, Name: name, Compass: CompassPoint(compass)}
+		if !p.Compass.valid() {
+			return Port{}, fmt.Errorf("%w: %q", ErrUnknownCompassPoint, compass)
+		}
+		return p, nil
+	}
+	if c := CompassPoint(v); c.valid() {
+		ports, err := n.Ports()
+		if err != nil {
+			return Port{}, err
+		}
+		for _, port := range ports {
+			if port == v {
+				return Port{Node: n, Name: v}, nil
+			}
+		}
+		return Port{Node: n, Compass: c}, nil
+	}
+	return Port{Node: n, Name: v}, nil
+}
+
+// Ports returns the port names defined by the HTML-like label or the record label of the node.
+// It returns nil if the node has neither of them.
+func (n *Node) Ports() ([]string, error) {
+	if n.IsHTML(string(labelAttr)) {
+		l, err := htmllabel.Parse(n.GetStr(string(labelAttr)))
+		if err != nil {
+			return nil, err
+		}
+		return l.Ports(), nil
+	}
+	switch Shape(n.GetStr(string(shapeAttr))) {
+	case RecordShape, MrecordShape:
+		l, err := n.RecordLabel()
+		if err != nil {
+			return nil, err
+		}
+		return l.Ports(), nil
+	}
+	return nil, nil
+}
+
+// CreateEdgeBetweenPorts creates an edge between the ports.
+// It returns an error if a node does not have the port or the compass point is invalid.
+func (g *Graph) CreateEdgeBetweenPorts(name string, tail Port, head Port) (*Edge, error) {
+	if err := tail.validate(); err != nil {
+		return nil, err
+	}
+	if err := head.validate(); err != nil {
+		return nil, err
+	}
+	e, err := g.CreateEdgeByName(name, tail.Node, head.Node)
+	if err != nil {
+		return nil, err
+	}
+	if v := tail.String(); v != "" {
+		if err := e.SafeSet(string(tailPortAttr), v, ""); err != nil {
+			return nil, err
+		}
+	}
+	if v := head.String(); v != "" {
+		if err := e.SafeSet(string(headPortAttr), v, ""); err != nil {
+			return nil, err
+		}
+	}
+	return e, nil
+}
+
+// Ports returns the tail and head ports of the edge from tailport and headport attributes.
+func (e *Edge) Ports() (tail Port, head Port, err error) {
+	tailNode, err := e.Tail()
+	if err != nil {
+		return Port{}, Port{}, err
+	}
+	headNode, err := e.Head()
+	if err != nil {
+		return Port{}, Port{}, err
+	}
+	tail, err = ParsePort(tailNode, e.GetStr(string(tailPortAttr)))
+	if err != nil {
+		return Port{}, Port{}, err
+	}
+	head, err = ParsePort(headNode, e.GetStr(string(headPortAttr)))
+	if err != nil {
+		return Port{}, Port{}, err
+	}
+	return tail, head, nil
+}
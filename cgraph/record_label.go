@@ -0,0 +1,18 @@
+package cgraph
+
+import (
+	"github.com/goccy/go-graphviz/cgraph/recordlabel"
+)
+
+// SetRecordLabel sets the label of the record or Mrecord shape.
+// https://graphviz.org/doc/info/shapes.html#record
+func (n *Node) SetRecordLabel(v recordlabel.Label) *Node {
+	n.SafeSet(string(labelAttr), v.String(), "\\N")
+	return n
+}
+
+// RecordLabel parses the label as a record label.
+// It can be used to find the ports of the node. e.g. n.RecordLabel().Ports()
+func (n *Node) RecordLabel() (recordlabel.Label, error) {
+	return recordlabel.Parse(n.GetStr(string(labelAttr)))
+}
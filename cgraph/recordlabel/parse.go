@@ -0,0 +1,132 @@
+package recordlabel
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Parse parses a record label such as "{<f0> a|<f1> b}".
+// Like Graphviz, spaces around texts and ports are ignored, and '\' escapes the special characters "{}|<> ".
+func Parse(s string) (Label, error) {
+	p := &parser{src: s}
+	l, err := p.parseLabel(false)
+	if err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+type parser struct {
+	src string
+	pos int
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at %d: %s", ErrSyntax, p.pos, fmt.Sprintf(format, args...))
+}
+
+// parseLabel parses fields until the end of the source, or '}' if nested.
+func (p *parser) parseLabel(nested bool) (Label, error) {
+	var l Label
+	for {
+		f, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		l = append(l, f)
+		if p.pos >= len(p.src) {
+			if nested {
+				return nil, p.errorf("missing '}'")
+			}
+			return l, nil
+		}
+		c := p.src[p.pos]
+		p.pos++
+		if c == '}' {
+			if !nested {
+				return nil, p.errorf("unexpected '}'")
+			}
+			return l, nil
+		}
+		// c is '|'.
+	}
+}
+
+// parseField parses a field until '|', '}' or the end of the source.
+func (p *parser) parseField() (*Field, error) {
+	var (
+		f          = &Field{}
+		text, port strings.Builder
+		// spaces are the unescaped spaces which are written only if a non-space character follows.
+		spaces  int
+		inPort  bool
+		hasPort bool
+	)
+	cur := &text
+	write := func(s string) {
+		if cur.Len() > 0 {
+			cur.WriteString(strings.Repeat(" ", spaces))
+		}
+		spaces = 0
+		cur.WriteString(s)
+	}
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		switch c {
+		case '|', '}':
+			if inPort {
+				return nil, p.errorf("missing '>'")
+			}
+			f.Text, f.Port = text.String(), port.String()
+			return f, nil
+		case '{':
+			if inPort || hasPort || text.Len() > 0 || f.IsGroup() {
+				return nil, p.errorf("unexpected '{'")
+			}
+			p.pos++
+			fields, err := p.parseLabel(true)
+			if err != nil {
+				return nil, err
+			}
+			f.Fields = fields
+			continue
+		case '<':
+			if inPort || hasPort || text.Len() > 0 || f.IsGroup() {
+				return nil, p.errorf("unexpected '<'")
+			}
+			inPort, hasPort = true, true
+			cur, spaces = &port, 0
+		case '>':
+			if !inPort {
+				return nil, p.errorf("unexpected '>'")
+			}
+			inPort = false
+			cur, spaces = &text, 0
+		case ' ', '\t', '\n', '\r':
+			spaces++
+		case '\\':
+			if p.pos+1 < len(p.src) && strings.IndexByte(specialChars+" ", p.src[p.pos+1]) >= 0 {
+				p.pos++
+				write(p.src[p.pos : p.pos+1])
+				break
+			}
+			if p.pos+1 < len(p.src) {
+				p.pos++
+				write(p.src[p.pos-1 : p.pos+1])
+				break
+			}
+			write(`\`)
+		default:
+			if f.IsGroup() {
+				return nil, p.errorf("unexpected %q after '}'", c)
+			}
+			write(p.src[p.pos : p.pos+1])
+		}
+		p.pos++
+	}
+	if inPort {
+		return nil, p.errorf("missing '>'")
+	}
+	f.Text, f.Port = text.String(), port.String()
+	return f, nil
+}
@@ -0,0 +1,170 @@
+// Package recordlabel builds and parses labels of the record and Mrecord shapes.
+// The grammar is described in https://graphviz.org/doc/info/shapes.html#record .
+//
+//	rlabel  = field ( '|' field )*
+//	field   = fieldId or '{' rlabel '}'
+//	fieldId = [ '<' string '>'] [ string ]
+package recordlabel
+
+import (
+	"errors"
+	"fmt"
+	"iter"
+	"strings"
+)
+
+var (
+	ErrSyntax        = errors.New("recordlabel: syntax error")
+	ErrDuplicatePort = errors.New("recordlabel: duplicate port")
+)
+
+// Label is a record label which is a list of fields separated by '|'.
+// The fields of the top level are laid out horizontally, or vertically if rankdir is LR or RL.
+type Label []*Field
+
+// Field is a field of a record label.
+// A field is either a text with an optional port or a group of nested fields written as '{' rlabel '}'.
+// A group flips the direction of the layout, so its fields are laid out perpendicular to the parent fields.
+type Field struct {
+	// Port is the name of the port which edges can be attached to as "node:port".
+	Port string
+	// Text is the text of the field. Escape sequences such as "\l" are kept as is.
+	Text string
+	// Fields are the fields of the group. It is nil if the field is not a group.
+	Fields Label
+}
+
+// NewField creates a field of the text.
+func NewField(text string) *Field {
+	return &Field{Text: text}
+}
+
+// NewGroup creates a group of the fields which are laid out perpendicular to the parent fields.
+func NewGroup(fields ...*Field) *Field {
+	return &Field{Fields: fields}
+}
+
+// WithPort sets the port name of the field.
+func (f *Field) WithPort(port string) *Field {
+	f.Port = port
+	return f
+}
+
+// IsGroup reports whether the field is a group of nested fields.
+func (f *Field) IsGroup() bool {
+	return f.Fields != nil
+}
+
+// String returns the label in the record label format.
+func (l Label) String() string {
+	var b strings.Builder
+	l.write(&b)
+	return b.String()
+}
+
+func (l Label) write(b *strings.Builder) {
+	for i, f := range l {
+		if i > 0 {
+			b.WriteByte('|')
+		}
+		f.write(b)
+	}
+}
+
+func (f *Field) write(b *strings.Builder) {
+	if f.IsGroup() {
+		b.WriteByte('{')
+		f.Fields.write(b)
+		b.WriteByte('}')
+		return
+	}
+	if f.Port != "" {
+		b.WriteByte('<')
+		b.WriteString(escape(f.Port))
+		b.WriteByte('>')
+		if f.Text != "" {
+			b.WriteByte(' ')
+		}
+	}
+	b.WriteString(escape(f.Text))
+}
+
+// Ports returns the port names of the fields including nested fields in the order they appear.
+func (l Label) Ports() []string {
+	var ports []string
+	for f := range l.fields() {
+		if f.Port != "" {
+			ports = append(ports, f.Port)
+		}
+	}
+	return ports
+}
+
+// HasPort reports whether a field including nested fields has the port.
+func (l Label) HasPort(port string) bool {
+	return l.FieldByPort(port) != nil
+}
+
+// FieldByPort returns the field which has the port. It returns nil if no field has it.
+func (l Label) FieldByPort(port string) *Field {
+	for f := range l.fields() {
+		if f.Port == port {
+			return f
+		}
+	}
+	return nil
+}
+
+// Validate reports whether a group has a port or text and whether ports are duplicated.
+func (l Label) Validate() error {
+	ports := map[string]struct{}{}
+	for f := range l.fields() {
+		if f.IsGroup() && (f.Port != "" || f.Text != "") {
+			return fmt.Errorf("%w: group cannot have a port or text", ErrSyntax)
+		}
+		if f.Port == "" {
+			continue
+		}
+		if _, exists := ports[f.Port]; exists {
+			return fmt.Errorf("%w: %q", ErrDuplicatePort, f.Port)
+		}
+		ports[f.Port] = struct{}{}
+	}
+	return nil
+}
+
+// fields returns all fields including nested fields in depth-first order.
+func (l Label) fields() iter.Seq[*Field] {
+	return func(yield func(*Field) bool) {
+		l.walk(yield)
+	}
+}
+
+func (l Label) walk(yield func(*Field) bool) bool {
+	for _, f := range l {
+		if !yield(f) {
+			return false
+		}
+		if !f.Fields.walk(yield) {
+			return false
+		}
+	}
+	return true
+}
+
+// specialChars are the characters which must be escaped with '\' in texts and ports.
+const specialChars = "{}|<>"
+
+func escape(s string) string {
+	if !strings.ContainsAny(s, specialChars) {
+		return s
+	}
+	var b strings.Builder
+	for _, c := range s {
+		if strings.ContainsRune(specialChars, c) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
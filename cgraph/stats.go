@@ -0,0 +1,189 @@
+package cgraph
+
+import (
+	"strings"
+)
+
+// GraphStats is the statistics of a graph returned by Stats.
+type GraphStats struct {
+	Name     string
+	Directed bool
+	// Nodes and Edges are the numbers of the nodes and edges including the ones of the nested subgraphs.
+	Nodes int
+	Edges int
+	// Components is the number of the connected components ignoring the direction of edges.
+	Components int
+	// SubGraphs is the number of the subgraphs including the nested ones, and Clusters is the number of them whose names start with "cluster".
+	SubGraphs int
+	Clusters  int
+	// SelfLoops is the number of the edges whose tail and head are the same node.
+	SelfLoops int
+	// Degrees, InDegrees and OutDegrees are the numbers of nodes by the total, in and out degrees.
+	Degrees    map[int]int
+	InDegrees  map[int]int
+	OutDegrees map[int]int
+	MinDegree  int
+	MaxDegree  int
+	// AverageDegree is the average of the total degrees.
+	AverageDegree float64
+	// Density is the ratio of the edges to the edges of the complete graph without self loops.
+	// It can exceed 1 with multi-edges and self loops.
+	Density float64
+	// Diameter is the lower bound of the diameter of the connected components ignoring the direction of edges,
+	// estimated by two breadth-first searches per component. It is exact for trees.
+	Diameter int
+	// IsDAG reports whether the graph is directed and has no cycles.
+	IsDAG bool
+	// IsTree reports whether the graph is connected and has no cycles ignoring the direction of edges.
+	IsTree bool
+	// Children are the statistics of the direct subgraphs.
+	Children []*GraphStats
+}
+
+// Stats returns the counts of the graph and the subgraphs per level like the gc tool of Graphviz,
+// and the structural metrics computed in linear time such as the degree distributions and the diameter estimate.
+// https://graphviz.org/docs/cli/gc/
+func Stats(g *Graph) (*GraphStats, error) {
+	name, err := g.Name()
+	if err != nil {
+		return nil, err
+	}
+	directed, err := g.IsDirected()
+	if err != nil {
+		return nil, err
+	}
+	s := &GraphStats{
+		Name:       name,
+		Directed:   directed,
+		Degrees:    map[int]int{},
+		InDegrees:  map[int]int{},
+		OutDegrees: map[int]int{},
+	}
+	if err := s.countDegrees(g); err != nil {
+		return nil, err
+	}
+	if err := s.computeStructure(g); err != nil {
+		return nil, err
+	}
+	sub, err := g.FirstSubGraph()
+	for ; sub != nil && err == nil; sub, err = sub.NextSubGraph() {
+		child, err := Stats(sub)
+		if err != nil {
+			return nil, err
+		}
+		s.Children = append(s.Children, child)
+		s.SubGraphs += child.SubGraphs + 1
+		s.Clusters += child.Clusters
+		if strings.HasPrefix(child.Name, "cluster") {
+			s.Clusters++
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *GraphStats) countDegrees(g *Graph) error {
+	totalDegree := 0
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return err
+		}
+		in, err := g.Indegree(n)
+		if err != nil {
+			return err
+		}
+		out, err := g.Outdegree(n)
+		if err != nil {
+			return err
+		}
+		degree := in + out
+		if s.Nodes == 0 || degree < s.MinDegree {
+			s.MinDegree = degree
+		}
+		s.MaxDegree = max(s.MaxDegree, degree)
+		s.Degrees[degree]++
+		s.InDegrees[in]++
+		s.OutDegrees[out]++
+		s.Nodes++
+		totalDegree += degree
+	}
+	for e, err := range g.Edges() {
+		if err != nil {
+			return err
+		}
+		k, err := edgeKey(e)
+		if err != nil {
+			return err
+		}
+		if k.Tail == k.Head {
+			s.SelfLoops++
+		}
+		s.Edges++
+	}
+	if s.Nodes > 0 {
+		s.AverageDegree = float64(totalDegree) / float64(s.Nodes)
+	}
+	if s.Nodes > 1 {
+		pairs := float64(s.Nodes) * float64(s.Nodes-1)
+		if !s.Directed {
+			pairs /= 2
+		}
+		s.Density = float64(s.Edges) / pairs
+	}
+	return nil
+}
+
+func (s *GraphStats) computeStructure(g *Graph) error {
+	adj, nodes, err := adjacency(g, false)
+	if err != nil {
+		return err
+	}
+	visited := map[string]bool{}
+	for _, n := range nodes {
+		if visited[n.name] {
+			continue
+		}
+		s.Components++
+		// the farthest node from any node is an end of a longest path in trees.
+		far, _ := farthest(adj, n.name, visited)
+		_, d := farthest(adj, far, nil)
+		s.Diameter = max(s.Diameter, d)
+	}
+	s.IsTree = s.Components == 1 && s.Edges == s.Nodes-1
+	if s.Directed && s.SelfLoops == 0 {
+		directedAdj, _, err := adjacency(g, true)
+		if err != nil {
+			return err
+		}
+		// a directed graph is acyclic if every strongly connected component is a single node.
+		s.IsDAG = len(tarjan(directedAdj, nodes)) == s.Nodes
+	}
+	return nil
+}
+
+// farthest returns the farthest node from the start node and the distance by a breadth-first search.
+// The visited nodes are recorded to visited if it is not nil.
+func farthest(adj map[string][]*adjacencyNode, start string, visited map[string]bool) (string, int) {
+	dist := map[string]int{start: 0}
+	queue := []string{start}
+	far := start
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if visited != nil {
+			visited[n] = true
+		}
+		if dist[n] > dist[far] {
+			far = n
+		}
+		for _, next := range adj[n] {
+			if _, exists := dist[next.name]; !exists {
+				dist[next.name] = dist[n] + 1
+				queue = append(queue, next.name)
+			}
+		}
+	}
+	return far, dist[far]
+}
@@ -0,0 +1,17 @@
+package cgraph
+
+import (
+	"context"
+)
+
+// TransitiveReduction removes the edges of the directed graph which are implied by other paths
+// in the same way as the tred tool of Graphviz. e.g. a -> c is removed from a -> b -> c and a -> c.
+// The remaining nodes and edges keep their attributes. Undirected graphs are not changed.
+// https://graphviz.org/docs/cli/tred/
+func TransitiveReduction(g *Graph) error {
+	res, err := g.wasm.ToolTred(context.Background())
+	if err != nil {
+		return err
+	}
+	return toError(res)
+}
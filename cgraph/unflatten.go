@@ -0,0 +1,161 @@
+package cgraph
+
+import (
+	"strconv"
+)
+
+// UnflattenOptions are the options of Unflatten. The zero value does nothing.
+type UnflattenOptions struct {
+	// MaxMinlen staggers the edges between a node and its leaves by setting their minlen to 1 .. MaxMinlen cyclically.
+	// Edges which already have minlen are not changed. It is -l of the unflatten tool.
+	MaxMinlen int
+	// Fans also staggers the out edges to nodes with exactly one in edge and one out edge. It requires MaxMinlen.
+	// It is -f of the unflatten tool.
+	Fans bool
+	// ChainLimit links disconnected nodes into chains of up to ChainLimit nodes by invisible edges.
+	// It is -c of the unflatten tool.
+	ChainLimit int
+}
+
+// Unflatten improves the aspect ratio of graphs having many leaves or disconnected nodes
+// for the dot layout in the same way as the unflatten tool of Graphviz.
+// https://graphviz.org/docs/cli/unflatten/
+func Unflatten(g *Graph, opts UnflattenOptions) error {
+	u := &unflatten{graph: g, opts: opts}
+	var nodes []*Node
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return err
+		}
+		nodes = append(nodes, n)
+	}
+	for _, n := range nodes {
+		degree, err := g.TotalDegree(n)
+		if err != nil {
+			return err
+		}
+		switch {
+		case degree == 0:
+			if err := u.chain(n); err != nil {
+				return err
+			}
+		case degree > 1:
+			if err := u.stagger(n); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type unflatten struct {
+	graph     *Graph
+	opts      UnflattenOptions
+	chainNode *Node
+	chainSize int
+}
+
+func (u *unflatten) chain(n *Node) error {
+	if u.opts.ChainLimit < 1 {
+		return nil
+	}
+	if u.chainNode == nil {
+		u.chainNode = n
+		return nil
+	}
+	e, err := createAnonymousEdge(u.graph, u.chainNode, n)
+	if err != nil {
+		return err
+	}
+	if err := e.SafeSet("style", "invis", ""); err != nil {
+		return err
+	}
+	u.chainSize++
+	if u.chainSize < u.opts.ChainLimit {
+		u.chainNode = n
+	} else {
+		u.chainNode = nil
+		u.chainSize = 0
+	}
+	return nil
+}
+
+func (u *unflatten) stagger(n *Node) error {
+	if u.opts.MaxMinlen < 1 {
+		return nil
+	}
+	cnt := 0
+	for e, err := range u.graph.InEdges(n) {
+		if err != nil {
+			return err
+		}
+		tail, err := e.Tail()
+		if err != nil {
+			return err
+		}
+		leaf, err := u.isLeaf(tail)
+		if err != nil {
+			return err
+		}
+		if !leaf || e.GetStr("minlen") != "" {
+			continue
+		}
+		if err := u.setMinlen(e, cnt); err != nil {
+			return err
+		}
+		cnt++
+	}
+	cnt = 0
+	for e, err := range u.graph.OutEdges(n) {
+		if err != nil {
+			return err
+		}
+		head, err := e.Head()
+		if err != nil {
+			return err
+		}
+		target, err := u.isLeaf(head)
+		if err != nil {
+			return err
+		}
+		if !target && u.opts.Fans {
+			if target, err = u.isChainNode(head); err != nil {
+				return err
+			}
+		}
+		if !target {
+			continue
+		}
+		if e.GetStr("minlen") == "" {
+			if err := u.setMinlen(e, cnt); err != nil {
+				return err
+			}
+		}
+		cnt++
+	}
+	return nil
+}
+
+func (u *unflatten) setMinlen(e *Edge, cnt int) error {
+	return e.SafeSet("minlen", strconv.Itoa(cnt%u.opts.MaxMinlen+1), "")
+}
+
+func (u *unflatten) isLeaf(n *Node) (bool, error) {
+	degree, err := u.graph.TotalDegree(n)
+	if err != nil {
+		return false, err
+	}
+	return degree == 1, nil
+}
+
+func (u *unflatten) isChainNode(n *Node) (bool, error) {
+	in, err := u.graph.Indegree(n)
+	if err != nil {
+		return false, err
+	}
+	out, err := u.graph.Outdegree(n)
+	if err != nil {
+		return false, err
+	}
+	return in == 1 && out == 1, nil
+}
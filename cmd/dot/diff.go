@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/goccy/go-graphviz"
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// DiffCommand renders the structural differences between two graphs.
+type DiffCommand struct {
+	Text bool `description:"print the differences as text instead of rendering the combined graph" long:"text"`
+
+	opt *Option
+}
+
+func (c *DiffCommand) Execute(args []string) error {
+	if len(args) != 2 {
+		return errors.New("diff requires two dot files")
+	}
+	return diff(context.Background(), args[0], args[1], c)
+}
+
+func diff(ctx context.Context, oldFile, newFile string, c *DiffCommand) (e error) {
+	oldGraph, err := graphviz.ParseFile(oldFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := oldGraph.Close(); err != nil {
+			e = err
+		}
+	}()
+	newGraph, err := graphviz.ParseFile(newFile)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := newGraph.Close(); err != nil {
+			e = err
+		}
+	}()
+	if c.Text {
+		d, err := cgraph.Diff(oldGraph, newGraph)
+		if err != nil {
+			return err
+		}
+		fmt.Print(d)
+		return nil
+	}
+	combined, err := cgraph.DiffGraph(oldGraph, newGraph)
+	if err != nil {
+		return err
+	}
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := combined.Close(); err != nil {
+			e = err
+		}
+		if err := g.Close(); err != nil {
+			e = err
+		}
+	}()
+	if c.opt.Layout != "" {
+		g.SetLayout(c.opt.Layout)
+	}
+	format := outputFormat(c.opt)
+	if c.opt.OutputFile == "" {
+		return g.Render(ctx, combined, format, os.Stdout)
+	}
+	return g.RenderFilename(ctx, combined, format, c.opt.OutputFile)
+}
@@ -1,36 +1,65 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/goccy/go-graphviz"
+	"github.com/goccy/go-graphviz/cgraph"
 	"github.com/jessevdk/go-flags"
 	"golang.org/x/term"
 )
 
 type Option struct {
-	Format     graphviz.Format `description:"specify output format ( currently supported: dot svg png jpg )" short:"T" default:"dot"`
-	Layout     graphviz.Layout `description:"specify layout engine ( currently supported: circo dot fdp neato nop nop1 nop2 osage patchwork sfdp twopi )" short:"K"`
-	OutputFile string          `description:"specify output file name" short:"o" required:"true"`
+	Format     graphviz.Format `description:"specify output format ( currently supported: dot svg png jpg drawlist text ascii sixel kitty )" short:"T"`
+	Layout     graphviz.Layout `description:"specify layout engine ( currently supported: auto circo dot fdp neato nop nop1 nop2 osage patchwork sfdp twopi )" short:"K"`
+	OutputFile string          `description:"specify output file name. If omitted, the result is written to stdout" short:"o"`
+	Verbose    bool            `description:"report attributes ignored by the layout engine and the engine selected by -K auto to stderr" short:"v"`
+	Tred       bool            `description:"remove transitive edges before the layout like the tred tool" long:"tred"`
 }
 
+// outputFormat returns the format specified by -T.
+// If it is omitted, the graph is previewed in the terminal when writing to it.
+func outputFormat(opt *Option) graphviz.Format {
+	if opt.Format != "" {
+		return opt.Format
+	}
+	if opt.OutputFile != "" || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return graphviz.XDOT
+	}
+	if os.Getenv("KITTY_WINDOW_ID") != "" || strings.Contains(os.Getenv("TERM"), "kitty") {
+		return graphviz.KITTY
+	}
+	return graphviz.TEXT
+}
+
+// readGraph reads the graph from the file or stdin.
+// The input is parsed as GraphML if it starts with an XML element, otherwise as DOT.
 func readGraph(args []string) (*graphviz.Graph, error) {
+	var (
+		src []byte
+		err error
+	)
 	if len(args) == 0 {
 		if term.IsTerminal(0) {
 			return nil, errors.New("required dot file or stdin")
 		}
-		bytes, err := io.ReadAll(os.Stdin)
-		if err != nil {
-			return nil, err
-		}
-		return graphviz.ParseBytes(bytes)
+		src, err = io.ReadAll(os.Stdin)
+	} else {
+		src, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return nil, err
 	}
-	dotFile := args[0]
-	return graphviz.ParseFile(dotFile)
+	if bytes.HasPrefix(bytes.TrimSpace(src), []byte("<")) {
+		return cgraph.ParseGraphML(bytes.NewReader(src))
+	}
+	return graphviz.ParseBytes(src)
 }
 
 func _main(ctx context.Context, args []string, opt *Option) (e error) {
@@ -53,16 +82,51 @@ func _main(ctx context.Context, args []string, opt *Option) (e error) {
 	if opt.Layout != "" {
 		g.SetLayout(opt.Layout)
 	}
-	return g.RenderFilename(ctx, graph, opt.Format, opt.OutputFile)
+	if opt.Tred {
+		if err := cgraph.TransitiveReduction(graph); err != nil {
+			return err
+		}
+	}
+	if opt.Verbose {
+		warnings, err := g.IgnoredAttributes(graph)
+		if err != nil {
+			return err
+		}
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "Warning: %s\n", w)
+		}
+		if s := g.LayoutSelection(); s != nil {
+			fmt.Fprintf(os.Stderr, "Layout: %s\n", s)
+		}
+	}
+	format := outputFormat(opt)
+	if opt.OutputFile == "" {
+		return g.Render(ctx, graph, format, os.Stdout)
+	}
+	return g.RenderFilename(ctx, graph, format, opt.OutputFile)
 }
 
 func main() {
 	var opt Option
 	parser := flags.NewParser(&opt, flags.Default)
+	parser.SubcommandsOptional = true
+	if _, err := parser.AddCommand(
+		"diff",
+		"render the differences between two graphs",
+		"Render the graph combining OLD and NEW dot files. Added items are green and removed items are red and dashed.",
+		&DiffCommand{opt: &opt},
+	); err != nil {
+		fmt.Println(err)
+		return
+	}
 	args, err := parser.Parse()
 	if err != nil {
 		return
 	}
+	if parser.Active != nil {
+		// the subcommand has been executed.
+		return
+	}
 	if err := _main(context.Background(), args, &opt); err != nil {
 		fmt.Println(err)
 	}
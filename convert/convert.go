@@ -0,0 +1,120 @@
+// Package convert converts graphs between cgraph and the GML and GXL formats
+// like the gml2gv, gv2gml, gxl2gv and gv2gxl tools of Graphviz.
+//
+// GML ( Graph Modelling Language ) has no subgraphs nor attribute declarations,
+// so subgraphs are written as group nodes with the isGroup and gid keys used by yEd,
+// and the attribute values of each object are written as its keys.
+// GXL ( Graph eXchange Language ) has nested graphs and typed attributes,
+// so subgraphs are written as nodes which have nested graphs, and declarations as the attr elements with the kind attribute.
+//
+// Both formats hold a node in only one graph, so a node is written in the innermost subgraph containing it,
+// and the membership of the other subgraphs which are not its ancestors is lost.
+//
+// Graphs are also exported to Mermaid flowcharts and PlantUML diagrams, which have fewer features than Graphviz,
+// so the exporters return the attribute values which cannot be represented as Unsupported.
+package convert
+
+import (
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// attributeObject is a graph, node or edge which has attributes.
+type attributeObject interface {
+	GetStr(name string) string
+	IsHTML(name string) bool
+}
+
+// attributeKinds are the kinds of the objects which have attributes.
+var attributeKinds = []cgraph.ObjectTag{cgraph.GRAPH, cgraph.NODE, cgraph.EDGE}
+
+// attributeDefaults returns the names and the defaults of the attributes declared for the kind of objects.
+func attributeDefaults(g *cgraph.Graph, kind cgraph.ObjectTag) ([]string, map[string]string, error) {
+	var names []string
+	defaults := map[string]string{}
+	for sym, err := range g.Attributes(kind) {
+		if err != nil {
+			return nil, nil, err
+		}
+		names = append(names, sym.Name())
+		defaults[sym.Name()] = sym.DefaultValue()
+	}
+	return names, defaults, nil
+}
+
+// subGraphs returns the direct subgraphs of the graph.
+func subGraphs(g *cgraph.Graph) ([]*cgraph.Graph, error) {
+	var ret []*cgraph.Graph
+	sub, err := g.FirstSubGraph()
+	for ; sub != nil && err == nil; sub, err = sub.NextSubGraph() {
+		ret = append(ret, sub)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// nodeParents returns the name of the innermost subgraph containing each node by the node name.
+// If a node is contained in sibling subgraphs, the last one is used. Nodes only in the root graph are not included.
+func nodeParents(g *cgraph.Graph) (map[string]string, error) {
+	parents := map[string]string{}
+	var walk func(*cgraph.Graph) error
+	walk = func(g *cgraph.Graph) error {
+		subs, err := subGraphs(g)
+		if err != nil {
+			return err
+		}
+		for _, sub := range subs {
+			subName, err := sub.Name()
+			if err != nil {
+				return err
+			}
+			for n, err := range sub.Nodes() {
+				if err != nil {
+					return err
+				}
+				name, err := n.Name()
+				if err != nil {
+					return err
+				}
+				parents[name] = subName
+			}
+			if err := walk(sub); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(g); err != nil {
+		return nil, err
+	}
+	return parents, nil
+}
+
+// graphDesc returns the descriptor of directed or undirected graphs.
+func graphDesc(directed bool) *cgraph.Desc {
+	if directed {
+		return cgraph.Directed
+	}
+	return cgraph.UnDirected
+}
+
+// isLatin1 reports whether the charset attribute is one of the names of Latin-1 accepted by Graphviz.
+func isLatin1(charset string) bool {
+	switch strings.ToLower(charset) {
+	case "latin-1", "latin1", "l1", "iso-8859-1", "iso_8859-1", "iso8859-1", "iso-ir-100":
+		return true
+	}
+	return false
+}
+
+// latin1ToUTF8 converts the string in Latin-1 to UTF-8.
+func latin1ToUTF8(s string) string {
+	runes := make([]rune, 0, len(s))
+	for i := 0; i < len(s); i++ {
+		runes = append(runes, rune(s[i]))
+	}
+	return string(runes)
+}
@@ -0,0 +1,479 @@
+package convert
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+	"github.com/goccy/go-graphviz/cgraph/recordlabel"
+)
+
+// Unsupported is an attribute value which an exporter cannot represent. The value is ignored or approximated.
+type Unsupported struct {
+	// Name and Value are the attribute. Name is empty if the objects themselves cannot be represented.
+	Name  string
+	Value string
+	// Objects are the objects which have the value such as "graph G", "subgraph cluster_0", "node a" and "edge a -> b".
+	Objects []string
+}
+
+func (u *Unsupported) String() string {
+	objects := strings.Join(u.Objects, ", ")
+	if u.Name == "" {
+		return fmt.Sprintf("%s: %s", u.Value, objects)
+	}
+	return fmt.Sprintf("%s=%q: %s", u.Name, u.Value, objects)
+}
+
+// exportStyle is the appearance of an object which the diagram languages commonly support.
+// Colors are "#rrggbb" or empty if not set.
+type exportStyle struct {
+	color     string
+	fillColor string
+	fontColor string
+	penWidth  float64
+	filled    bool
+	dashed    bool
+	dotted    bool
+	bold      bool
+	rounded   bool
+	invisible bool
+}
+
+type exportNode struct {
+	// id is the identifier in the diagram such as "n0".
+	id      string
+	name    string
+	label   string
+	shape   cgraph.Shape
+	style   exportStyle
+	cluster *exportCluster
+	object  string
+}
+
+type exportEdge struct {
+	tail, head *exportNode
+	label      string
+	// dir is forward, back, both or none resolved with the directedness of the graph.
+	dir    cgraph.DirType
+	style  exportStyle
+	object string
+}
+
+// exportCluster is a cluster subgraph. Other subgraphs are not drawn by Graphviz, so they are not exported.
+type exportCluster struct {
+	// id is the identifier in the diagram such as "c0".
+	id       string
+	name     string
+	label    string
+	style    exportStyle
+	parent   *exportCluster
+	clusters []*exportCluster
+	nodes    []*exportNode
+	object   string
+}
+
+// exportGraph is the graph to export as a diagram.
+type exportGraph struct {
+	name     string
+	label    string
+	directed bool
+	rankdir  cgraph.RankDir
+	// clusters are the top-level clusters and nodes are all nodes. Nodes whose cluster is nil are in the top level.
+	clusters    []*exportCluster
+	nodes       []*exportNode
+	edges       []*exportEdge
+	clusterNum  int
+	object      string
+	latin1      bool
+	unsupported []*Unsupported
+}
+
+// exportedAttributes are the attributes read by newExportGraph for each kind of objects.
+// Other attributes are reported as unsupported.
+var exportedAttributes = map[string]map[string]bool{
+	"graph":   {"label": true, "rankdir": true, "charset": true},
+	"cluster": {"label": true, "color": true, "fillcolor": true, "bgcolor": true, "fontcolor": true, "pencolor": true, "penwidth": true, "style": true},
+	"node":    {"label": true, "shape": true, "color": true, "fillcolor": true, "fontcolor": true, "penwidth": true, "style": true},
+	"edge":    {"label": true, "color": true, "fontcolor": true, "penwidth": true, "style": true, "dir": true},
+}
+
+// newExportGraph reads the graph, reporting the attributes which the diagram languages cannot represent.
+func newExportGraph(g *cgraph.Graph) (*exportGraph, error) {
+	name, err := g.Name()
+	if err != nil {
+		return nil, err
+	}
+	directed, err := g.IsDirected()
+	if err != nil {
+		return nil, err
+	}
+	eg := &exportGraph{directed: directed, latin1: isLatin1(g.GetStr("charset"))}
+	eg.name = eg.text(name)
+	eg.object = "graph " + eg.name
+	eg.rankdir, _ = g.RankDir()
+	if label := g.GetStr("label"); label != "" {
+		eg.label = eg.labelText(eg.object, label, g.IsHTML("label"), eg.name, nil)
+	}
+	graphNames, _, err := attributeDefaults(g, cgraph.GRAPH)
+	if err != nil {
+		return nil, err
+	}
+	rootValues := map[string]string{}
+	for _, name := range graphNames {
+		rootValues[name] = g.GetStr(name)
+		eg.reportAttribute(eg.object, "graph", name, rootValues[name], "")
+	}
+	nodeNames, _, err := attributeDefaults(g, cgraph.NODE)
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[string]*exportNode{}
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return nil, err
+		}
+		node, err := eg.newNode(n, nodeNames)
+		if err != nil {
+			return nil, err
+		}
+		nodes[node.name] = node
+		eg.nodes = append(eg.nodes, node)
+	}
+	if err := eg.readClusters(g, nil, graphNames, rootValues, nodes); err != nil {
+		return nil, err
+	}
+	// the nodes are listed in the innermost clusters after all clusters are read.
+	for _, n := range eg.nodes {
+		if n.cluster != nil {
+			n.cluster.nodes = append(n.cluster.nodes, n)
+		}
+	}
+	edgeNames, _, err := attributeDefaults(g, cgraph.EDGE)
+	if err != nil {
+		return nil, err
+	}
+	for e, err := range g.Edges() {
+		if err != nil {
+			return nil, err
+		}
+		edge, err := eg.newEdge(e, edgeNames, nodes)
+		if err != nil {
+			return nil, err
+		}
+		eg.edges = append(eg.edges, edge)
+	}
+	return eg, nil
+}
+
+// report records the unsupported value of the object.
+func (eg *exportGraph) report(object, name, value string) {
+	for _, u := range eg.unsupported {
+		if u.Name == name && u.Value == value {
+			u.Objects = append(u.Objects, object)
+			return
+		}
+	}
+	eg.unsupported = append(eg.unsupported, &Unsupported{Name: name, Value: value, Objects: []string{object}})
+}
+
+// reportAttribute reports the attribute if it is not read for the kind of objects and its value differs from base.
+func (eg *exportGraph) reportAttribute(object, kind, name, value, base string) {
+	if value == base || exportedAttributes[kind][name] {
+		return
+	}
+	eg.report(object, name, eg.text(value))
+}
+
+// text returns the string in UTF-8.
+func (eg *exportGraph) text(s string) string {
+	if !eg.latin1 {
+		return s
+	}
+	return latin1ToUTF8(s)
+}
+
+func (eg *exportGraph) newNode(n *cgraph.Node, attrNames []string) (*exportNode, error) {
+	name, err := n.Name()
+	if err != nil {
+		return nil, err
+	}
+	node := &exportNode{id: "n" + strconv.Itoa(len(eg.nodes)), name: eg.text(name)}
+	node.object = "node " + node.name
+	for _, attr := range attrNames {
+		eg.reportAttribute(node.object, "node", attr, n.GetStr(attr), "")
+	}
+	node.shape, _ = n.Shape()
+	label, isHTML := n.GetStr("label"), n.IsHTML("label")
+	if !isHTML && (node.shape == cgraph.RecordShape || node.shape == cgraph.MrecordShape) {
+		label = recordText(label)
+	}
+	node.label = eg.labelText(node.object, label, isHTML, node.name, map[byte]string{'G': eg.name})
+	color, _ := n.Color()
+	fillColor, _ := n.FillColor()
+	fontColor, _ := n.FontColor()
+	node.style = eg.readStyle(node.object, n.GetStr, color, fillColor, fontColor)
+	return node, nil
+}
+
+// readClusters reads the cluster subgraphs and assigns the nodes to the innermost clusters.
+// The attributes of the subgraphs which differ from the root graph are reported except the ones read for clusters.
+func (eg *exportGraph) readClusters(g *cgraph.Graph, parent *exportCluster, attrNames []string, rootValues map[string]string, nodes map[string]*exportNode) error {
+	subs, err := subGraphs(g)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		name, err := sub.Name()
+		if err != nil {
+			return err
+		}
+		name = eg.text(name)
+		if !strings.HasPrefix(name, "cluster") {
+			for _, attr := range attrNames {
+				eg.reportAttribute("subgraph "+name, "subgraph", attr, sub.GetStr(attr), rootValues[attr])
+			}
+			if err := eg.readClusters(sub, parent, attrNames, rootValues, nodes); err != nil {
+				return err
+			}
+			continue
+		}
+		eg.clusterNum++
+		c := &exportCluster{id: "c" + strconv.Itoa(eg.clusterNum-1), name: name, parent: parent, object: "subgraph " + name}
+		for _, attr := range attrNames {
+			eg.reportAttribute(c.object, "cluster", attr, sub.GetStr(attr), rootValues[attr])
+		}
+		if label := sub.GetStr("label"); label != "" {
+			c.label = eg.labelText(c.object, label, sub.IsHTML("label"), name, nil)
+		}
+		color, _ := sub.Color()
+		if pencolor := sub.GetStr("pencolor"); pencolor != "" {
+			if color, err = cgraph.ParseColorList(pencolor); err != nil {
+				eg.report(c.object, "pencolor", eg.text(pencolor))
+			}
+		}
+		fillColor, _ := sub.FillColor()
+		if bgcolor := sub.GetStr("bgcolor"); bgcolor != "" && sub.GetStr("fillcolor") == "" {
+			// the background of a cluster is filled by bgcolor without style=filled.
+			if fillColor, err = cgraph.ParseColorList(bgcolor); err != nil {
+				eg.report(c.object, "bgcolor", eg.text(bgcolor))
+			}
+		}
+		fontColor, _ := sub.FontColor()
+		c.style = eg.readStyle(c.object, sub.GetStr, color, fillColor, fontColor)
+		if sub.GetStr("bgcolor") != "" {
+			c.style.filled = true
+		}
+		if parent == nil {
+			eg.clusters = append(eg.clusters, c)
+		} else {
+			parent.clusters = append(parent.clusters, c)
+		}
+		for n, err := range sub.Nodes() {
+			if err != nil {
+				return err
+			}
+			name, err := n.Name()
+			if err != nil {
+				return err
+			}
+			nodes[eg.text(name)].cluster = c
+		}
+		if err := eg.readClusters(sub, c, attrNames, rootValues, nodes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (eg *exportGraph) newEdge(e *cgraph.Edge, attrNames []string, nodes map[string]*exportNode) (*exportEdge, error) {
+	k, err := edgeEndpoints(e)
+	if err != nil {
+		return nil, err
+	}
+	edge := &exportEdge{tail: nodes[eg.text(k[0])], head: nodes[eg.text(k[1])]}
+	op := " -- "
+	if eg.directed {
+		op = " -> "
+	}
+	edge.object = "edge " + edge.tail.name + op + edge.head.name
+	for _, attr := range attrNames {
+		eg.reportAttribute(edge.object, "edge", attr, e.GetStr(attr), "")
+	}
+	if label := e.GetStr("label"); label != "" {
+		key, err := e.Name()
+		if err != nil {
+			return nil, err
+		}
+		edge.label = eg.labelText(edge.object, label, e.IsHTML("label"), eg.text(key), map[byte]string{
+			'G': eg.name, 'E': eg.text(key), 'T': edge.tail.name, 'H': edge.head.name,
+		})
+	}
+	edge.dir = cgraph.DirType(e.GetStr("dir"))
+	switch edge.dir {
+	case cgraph.ForwardDir, cgraph.BackDir, cgraph.BothDir, cgraph.NoneDir:
+	case "":
+		edge.dir = cgraph.NoneDir
+		if eg.directed {
+			edge.dir = cgraph.ForwardDir
+		}
+	default:
+		eg.report(edge.object, "dir", string(edge.dir))
+		edge.dir = cgraph.ForwardDir
+	}
+	color, _ := e.Color()
+	fontColor, _ := e.FontColor()
+	edge.style = eg.readStyle(edge.object, e.GetStr, color, nil, fontColor)
+	return edge, nil
+}
+
+func edgeEndpoints(e *cgraph.Edge) ([2]string, error) {
+	tail, err := e.Tail()
+	if err != nil {
+		return [2]string{}, err
+	}
+	head, err := e.Head()
+	if err != nil {
+		return [2]string{}, err
+	}
+	tailName, err := tail.Name()
+	if err != nil {
+		return [2]string{}, err
+	}
+	headName, err := head.Name()
+	if err != nil {
+		return [2]string{}, err
+	}
+	return [2]string{tailName, headName}, nil
+}
+
+// readStyle reads the style, penwidth and colors of the object.
+func (eg *exportGraph) readStyle(object string, get func(string) string, color, fillColor cgraph.ColorList, fontColor cgraph.Color) exportStyle {
+	var s exportStyle
+	for _, v := range strings.Split(get("style"), ",") {
+		switch v = strings.TrimSpace(v); v {
+		case "", "solid":
+		case "filled":
+			s.filled = true
+		case "dashed":
+			s.dashed = true
+		case "dotted":
+			s.dotted = true
+		case "bold":
+			s.bold = true
+		case "rounded":
+			s.rounded = true
+		case "invis":
+			s.invisible = true
+		default:
+			eg.report(object, "style", v)
+		}
+	}
+	if v := get("penwidth"); v != "" {
+		w, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			eg.report(object, "penwidth", v)
+		}
+		s.penWidth = w
+	}
+	if get("color") != "" || get("pencolor") != "" {
+		s.color = eg.colorValue(object, "color", color)
+	}
+	if get("fillcolor") != "" || get("bgcolor") != "" {
+		s.fillColor = eg.colorValue(object, "fillcolor", fillColor)
+	} else if s.filled && s.color != "" {
+		// the fill color is the color attribute if fillcolor is not set.
+		s.fillColor = eg.colorValue(object, "color", color)
+	} else if s.filled {
+		s.fillColor = "#d3d3d3"
+	}
+	if get("fontcolor") != "" {
+		s.fontColor = eg.colorValue(object, "fontcolor", cgraph.ColorList{{Color: fontColor}})
+	}
+	return s
+}
+
+// colorValue returns the first color of the list as "#rrggbb". Gradients and transparency are reported.
+func (eg *exportGraph) colorValue(object, name string, colors cgraph.ColorList) string {
+	if len(colors) == 0 {
+		return ""
+	}
+	if len(colors) > 1 {
+		eg.report(object, name, colors.String())
+	}
+	c := colors[0].Color
+	if c.A != 0xff {
+		eg.report(object, name, c.String())
+	}
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+var (
+	htmlBreakPattern = regexp.MustCompile(`(?i)<br\s*[^>]*>`)
+	htmlTagPattern   = regexp.MustCompile(`<[^>]*>`)
+)
+
+// labelText returns the text of the label with line breaks as '\n'.
+// The escape sequences \N, \n, \l and \r are replaced, and the ones in escapes such as \G are replaced by the values.
+// HTML-like labels, whose values are HTML strings, are reported and converted to the texts without the tags.
+func (eg *exportGraph) labelText(object, label string, isHTML bool, name string, escapes map[byte]string) string {
+	label = eg.text(label)
+	if isHTML {
+		eg.report(object, "label", label)
+		text := htmlBreakPattern.ReplaceAllString(label, "\n")
+		text = html.UnescapeString(htmlTagPattern.ReplaceAllString(text, ""))
+		lines := strings.Split(text, "\n")
+		var ret []string
+		for _, line := range lines {
+			if line = strings.Join(strings.Fields(line), " "); line != "" {
+				ret = append(ret, line)
+			}
+		}
+		return strings.Join(ret, "\n")
+	}
+	var b strings.Builder
+	for i := 0; i < len(label); i++ {
+		if label[i] != '\\' || i+1 == len(label) {
+			b.WriteByte(label[i])
+			continue
+		}
+		i++
+		switch c := label[i]; c {
+		case 'N':
+			b.WriteString(name)
+		case 'n', 'l', 'r':
+			b.WriteByte('\n')
+		default:
+			if v, exists := escapes[c]; exists {
+				b.WriteString(v)
+			} else {
+				b.WriteByte(c)
+			}
+		}
+	}
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// recordText returns the label of the record shape as a text whose fields are separated by " | ".
+func recordText(label string) string {
+	l, err := recordlabel.Parse(label)
+	if err != nil {
+		return label
+	}
+	var fields []string
+	var walk func(recordlabel.Label)
+	walk = func(l recordlabel.Label) {
+		for _, f := range l {
+			if f.IsGroup() {
+				walk(f.Fields)
+			} else {
+				fields = append(fields, f.Text)
+			}
+		}
+	}
+	walk(l)
+	return strings.Join(fields, " | ")
+}
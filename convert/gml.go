@@ -0,0 +1,655 @@
+package convert
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+var ErrGML = errors.New("invalid GML")
+
+// gmlPair is a key-value pair of GML. The value is a list if list is not nil, otherwise a string, an integer or a real.
+type gmlPair struct {
+	key   string
+	value string
+	list  []*gmlPair
+}
+
+// gmlValue returns the scalar value of the first pair of the key.
+func gmlValue(pairs []*gmlPair, key string) (string, bool) {
+	for _, p := range pairs {
+		if p.key == key && p.list == nil {
+			return p.value, true
+		}
+	}
+	return "", false
+}
+
+// gmlReservedKeys are the keys of GML used for the structure, which are not read as attributes.
+// The attributes of these names are written as attribute lists.
+var gmlReservedKeys = map[string]bool{
+	"id":            true,
+	"name":          true,
+	"directed":      true,
+	"graph":         true,
+	"node":          true,
+	"edge":          true,
+	"source":        true,
+	"target":        true,
+	"key":           true,
+	"isGroup":       true,
+	"gid":           true,
+	"graphics":      true,
+	"LabelGraphics": true,
+	"attribute":     true,
+}
+
+var gmlKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+type gmlScanner struct {
+	src  string
+	pos  int
+	line int
+}
+
+func (s *gmlScanner) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w: line %d: %s", ErrGML, s.line, fmt.Sprintf(format, args...))
+}
+
+// skipSpaces skips white spaces and comments starting with '#'.
+func (s *gmlScanner) skipSpaces() {
+	for s.pos < len(s.src) {
+		switch c := s.src[s.pos]; {
+		case c == '\n':
+			s.line++
+			s.pos++
+		case c == ' ' || c == '\t' || c == '\r':
+			s.pos++
+		case c == '#':
+			for s.pos < len(s.src) && s.src[s.pos] != '\n' {
+				s.pos++
+			}
+		default:
+			return
+		}
+	}
+}
+
+// parseList parses the pairs until ']' if nested, otherwise until the end.
+func (s *gmlScanner) parseList(nested bool) ([]*gmlPair, error) {
+	var pairs []*gmlPair
+	for {
+		s.skipSpaces()
+		if s.pos >= len(s.src) {
+			if nested {
+				return nil, s.errorf("unterminated list")
+			}
+			return pairs, nil
+		}
+		if s.src[s.pos] == ']' {
+			if !nested {
+				return nil, s.errorf("unexpected ']'")
+			}
+			s.pos++
+			return pairs, nil
+		}
+		start := s.pos
+		for s.pos < len(s.src) && isGMLKeyChar(s.src[s.pos], s.pos == start) {
+			s.pos++
+		}
+		if start == s.pos {
+			return nil, s.errorf("unexpected character %q", s.src[s.pos])
+		}
+		p := &gmlPair{key: s.src[start:s.pos]}
+		if err := s.parseValue(p); err != nil {
+			return nil, err
+		}
+		pairs = append(pairs, p)
+	}
+}
+
+func isGMLKeyChar(c byte, first bool) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || (!first && '0' <= c && c <= '9')
+}
+
+func (s *gmlScanner) parseValue(p *gmlPair) error {
+	s.skipSpaces()
+	if s.pos >= len(s.src) {
+		return s.errorf("missing value of %s", p.key)
+	}
+	switch c := s.src[s.pos]; {
+	case c == '[':
+		s.pos++
+		list, err := s.parseList(true)
+		if err != nil {
+			return err
+		}
+		// an empty list is distinguished from a scalar by the non-nil slice.
+		p.list = append([]*gmlPair{}, list...)
+	case c == '"':
+		end := strings.IndexByte(s.src[s.pos+1:], '"')
+		if end < 0 {
+			return s.errorf("unterminated string")
+		}
+		value := s.src[s.pos+1 : s.pos+1+end]
+		s.line += strings.Count(value, "\n")
+		s.pos += end + 2
+		p.value = html.UnescapeString(value)
+	default:
+		start := s.pos
+		for s.pos < len(s.src) && strings.IndexByte("+-.0123456789eE", s.src[s.pos]) >= 0 {
+			s.pos++
+		}
+		if _, err := strconv.ParseFloat(s.src[start:s.pos], 64); err != nil {
+			return s.errorf("invalid value of %s", p.key)
+		}
+		p.value = s.src[start:s.pos]
+	}
+	return nil
+}
+
+// ParseGML reads the first graph of the GML document like the gml2gv tool.
+//
+// Nodes are named by the name keys, or the ids if omitted, and the other keys of scalar values are set as the attributes.
+// Group nodes ( isGroup 1 ) become the subgraphs and the nodes which refer to them by gid are created in them.
+// The x, y, w, h, type, fill, outline and width keys of node graphics are mapped to pos, width, height, shape,
+// fillcolor, color and penwidth, and the fill, width, style and arrow keys of edge graphics to color, penwidth, style and dir.
+func ParseGML(r io.Reader) (*cgraph.Graph, error) {
+	src, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	s := &gmlScanner{src: string(src), line: 1}
+	pairs, err := s.parseList(false)
+	if err != nil {
+		return nil, err
+	}
+	var graph []*gmlPair
+	for _, p := range pairs {
+		if p.key == "graph" && p.list != nil {
+			graph = p.list
+			break
+		}
+	}
+	if graph == nil {
+		return nil, fmt.Errorf("%w: no graph", ErrGML)
+	}
+	directed, _ := gmlValue(graph, "directed")
+	name, _ := gmlValue(graph, "name")
+	g, err := cgraph.Open(name, graphDesc(directed == "1"), nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &gmlParser{root: g, nodes: map[string]*gmlNode{}}
+	if err := p.parse(graph); err != nil {
+		_ = g.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+type gmlParser struct {
+	root *cgraph.Graph
+	// nodes are the nodes and the group nodes by id.
+	nodes map[string]*gmlNode
+}
+
+type gmlNode struct {
+	pairs []*gmlPair
+	// group is the subgraph if the node is a group node.
+	group *cgraph.Graph
+	node  *cgraph.Node
+	// creating is true while the parent groups are created to detect cycles.
+	creating bool
+}
+
+func (p *gmlParser) parse(graph []*gmlPair) error {
+	for name, def := range defaultNodeValues {
+		if _, err := p.root.Attr(int(cgraph.NODE), name, def); err != nil {
+			return err
+		}
+	}
+	if err := setGMLAttributes(p.root, graph); err != nil {
+		return err
+	}
+	var ids []string
+	for _, pair := range graph {
+		if pair.key != "node" || pair.list == nil {
+			continue
+		}
+		id, exists := gmlValue(pair.list, "id")
+		if !exists {
+			return fmt.Errorf("%w: node without id", ErrGML)
+		}
+		if _, exists := p.nodes[id]; exists {
+			return fmt.Errorf("%w: duplicate node id %s", ErrGML, id)
+		}
+		p.nodes[id] = &gmlNode{pairs: pair.list}
+		ids = append(ids, id)
+	}
+	for _, id := range ids {
+		if _, err := p.create(id); err != nil {
+			return err
+		}
+	}
+	for _, pair := range graph {
+		if pair.key != "edge" || pair.list == nil {
+			continue
+		}
+		if err := p.createEdge(pair.list); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// create creates the node or the subgraph of the group node after its parent group, and returns the subgraph of the group node.
+func (p *gmlParser) create(id string) (*cgraph.Graph, error) {
+	n := p.nodes[id]
+	if n.group != nil || n.node != nil {
+		return n.group, nil
+	}
+	if n.creating {
+		return nil, fmt.Errorf("%w: cyclic group %s", ErrGML, id)
+	}
+	n.creating = true
+	parent := p.root
+	if gid, exists := gmlValue(n.pairs, "gid"); exists {
+		group, ok := p.nodes[gid]
+		if !ok || !isGMLGroup(group.pairs) {
+			return nil, fmt.Errorf("%w: node %s refers to an unknown group %s", ErrGML, id, gid)
+		}
+		g, err := p.create(gid)
+		if err != nil {
+			return nil, err
+		}
+		parent = g
+	}
+	name, exists := gmlValue(n.pairs, "name")
+	if !exists {
+		name = id
+	}
+	if isGMLGroup(n.pairs) {
+		sub, err := parent.CreateSubGraphByName(name)
+		if err != nil {
+			return nil, err
+		}
+		n.group = sub
+		return sub, setGMLAttributes(sub, n.pairs)
+	}
+	node, err := parent.CreateNodeByName(name)
+	if err != nil {
+		return nil, err
+	}
+	n.node = node
+	if err := setGMLNodeGraphics(node, n.pairs); err != nil {
+		return nil, err
+	}
+	return nil, setGMLAttributes(node, n.pairs)
+}
+
+func isGMLGroup(pairs []*gmlPair) bool {
+	v, _ := gmlValue(pairs, "isGroup")
+	return v == "1"
+}
+
+func (p *gmlParser) endpoint(pairs []*gmlPair, key string) (*cgraph.Node, error) {
+	id, _ := gmlValue(pairs, key)
+	n, exists := p.nodes[id]
+	if !exists || n.node == nil {
+		return nil, fmt.Errorf("%w: edge refers to an unknown node %q", ErrGML, id)
+	}
+	return n.node, nil
+}
+
+func (p *gmlParser) createEdge(pairs []*gmlPair) error {
+	tail, err := p.endpoint(pairs, "source")
+	if err != nil {
+		return err
+	}
+	head, err := p.endpoint(pairs, "target")
+	if err != nil {
+		return err
+	}
+	var e *cgraph.Edge
+	if key, exists := gmlValue(pairs, "key"); exists && key != "" {
+		e, err = p.root.CreateEdgeByName(key, tail, head)
+	} else {
+		e, err = p.root.CreateAnonymousEdge(tail, head)
+	}
+	if err != nil {
+		return err
+	}
+	if err := setGMLEdgeGraphics(e, pairs); err != nil {
+		return err
+	}
+	return setGMLAttributes(e, pairs)
+}
+
+type gmlObject interface {
+	SafeSet(name, value, def string) error
+	SafeSetHTML(name, value, def string) error
+}
+
+// setGMLAttributes sets the scalar values except the reserved keys and the attribute lists as the attributes.
+// The values of the attribute lists with html 1 are set as HTML strings.
+func setGMLAttributes(obj gmlObject, pairs []*gmlPair) error {
+	for _, pair := range pairs {
+		if pair.key == "attribute" && pair.list != nil {
+			name, _ := gmlValue(pair.list, "name")
+			value, _ := gmlValue(pair.list, "value")
+			if name == "" {
+				return fmt.Errorf("%w: attribute without name", ErrGML)
+			}
+			set := obj.SafeSet
+			if html, _ := gmlValue(pair.list, "html"); html == "1" {
+				set = obj.SafeSetHTML
+			}
+			if err := set(name, value, ""); err != nil {
+				return err
+			}
+			continue
+		}
+		if pair.list != nil || gmlReservedKeys[pair.key] {
+			continue
+		}
+		if err := obj.SafeSet(pair.key, pair.value, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// graphics returns the graphics list of the object.
+func graphics(pairs []*gmlPair) []*gmlPair {
+	for _, p := range pairs {
+		if p.key == "graphics" && p.list != nil {
+			return p.list
+		}
+	}
+	return nil
+}
+
+// inches converts the length in points to inches.
+func inches(points string) string {
+	v, err := strconv.ParseFloat(points, 64)
+	if err != nil {
+		return points
+	}
+	return strconv.FormatFloat(v/72, 'g', -1, 64)
+}
+
+func setGMLNodeGraphics(n *cgraph.Node, pairs []*gmlPair) error {
+	gr := graphics(pairs)
+	if gr == nil {
+		return nil
+	}
+	attrs := map[string]string{}
+	x, hasX := gmlValue(gr, "x")
+	y, hasY := gmlValue(gr, "y")
+	if hasX && hasY {
+		attrs["pos"] = x + "," + y
+	}
+	if w, exists := gmlValue(gr, "w"); exists {
+		attrs["width"] = inches(w)
+	}
+	if h, exists := gmlValue(gr, "h"); exists {
+		attrs["height"] = inches(h)
+	}
+	if t, exists := gmlValue(gr, "type"); exists {
+		attrs["shape"] = t
+	}
+	if fill, exists := gmlValue(gr, "fill"); exists {
+		attrs["fillcolor"] = fill
+		attrs["style"] = "filled"
+	}
+	if outline, exists := gmlValue(gr, "outline"); exists {
+		attrs["color"] = outline
+	}
+	if width, exists := gmlValue(gr, "width"); exists {
+		attrs["penwidth"] = width
+	}
+	return setAll(n, attrs)
+}
+
+func setGMLEdgeGraphics(e *cgraph.Edge, pairs []*gmlPair) error {
+	gr := graphics(pairs)
+	if gr == nil {
+		return nil
+	}
+	attrs := map[string]string{}
+	if fill, exists := gmlValue(gr, "fill"); exists {
+		attrs["color"] = fill
+	}
+	if width, exists := gmlValue(gr, "width"); exists {
+		attrs["penwidth"] = width
+	}
+	if style, exists := gmlValue(gr, "style"); exists {
+		attrs["style"] = style
+	}
+	if arrow, exists := gmlValue(gr, "arrow"); exists {
+		switch arrow {
+		case "none":
+			attrs["dir"] = "none"
+		case "first":
+			attrs["dir"] = "back"
+		case "last":
+			attrs["dir"] = "forward"
+		case "both":
+			attrs["dir"] = "both"
+		}
+	}
+	return setAll(e, attrs)
+}
+
+func setAll(obj gmlObject, attrs map[string]string) error {
+	for name, value := range attrs {
+		if err := obj.SafeSet(name, value, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGML writes the graph as a GML document like the gv2gml tool.
+//
+// The attribute values of each object are written as the keys except the empty ones and the default node label "\N".
+// Subgraphs have the values which differ from the root graph including the empty ones.
+// Attributes whose names are reserved by GML or are not valid keys are written as lists like attribute [ name "id" value "a" ],
+// and so are HTML strings with html 1 like attribute [ name "label" value "<b>bold</b>" html 1 ].
+// Subgraphs are written as the group nodes which have the isGroup key, and nodes refer to the innermost group containing them by gid.
+func WriteGML(w io.Writer, g *cgraph.Graph) error {
+	wr := &gmlWriter{ids: map[string]int{}, groups: map[string]int{}, names: map[cgraph.ObjectTag][]string{}}
+	for _, kind := range attributeKinds {
+		names, _, err := attributeDefaults(g, kind)
+		if err != nil {
+			return err
+		}
+		wr.names[kind] = names
+	}
+	directed, err := g.IsDirected()
+	if err != nil {
+		return err
+	}
+	name, err := g.Name()
+	if err != nil {
+		return err
+	}
+	wr.b.WriteString("graph [\n")
+	wr.writeInt(1, "directed", boolToInt(directed))
+	wr.writeString(1, "name", name)
+	wr.writeAttributes(1, cgraph.GRAPH, g, nil)
+	// subgraphs inherit the values of the root graph when they are read, so the different values including empty ones are written.
+	wr.rootValues = map[string]string{}
+	for _, name := range wr.names[cgraph.GRAPH] {
+		wr.rootValues[name] = g.GetStr(name)
+	}
+	if err := wr.writeGroups(g, -1); err != nil {
+		return err
+	}
+	if err := wr.writeNodes(g); err != nil {
+		return err
+	}
+	if err := wr.writeEdges(g); err != nil {
+		return err
+	}
+	wr.b.WriteString("]\n")
+	_, err = io.WriteString(w, wr.b.String())
+	return err
+}
+
+type gmlWriter struct {
+	b strings.Builder
+	// ids are the ids of the nodes, and groups are the ids of the group nodes by name.
+	ids    map[string]int
+	groups map[string]int
+	nextID int
+	names  map[cgraph.ObjectTag][]string
+	// rootValues are the attribute values of the root graph.
+	rootValues map[string]string
+}
+
+// defaultNodeValues are the defaults of the node attributes which ParseGML declares.
+// The label of Graphviz is the node name by default.
+var defaultNodeValues = map[string]string{"label": `\N`}
+
+var gmlEscaper = strings.NewReplacer("&", "&amp;", `"`, "&quot;")
+
+func (wr *gmlWriter) writeInt(depth int, key string, v int) {
+	fmt.Fprintf(&wr.b, "%s%s %d\n", strings.Repeat("  ", depth), key, v)
+}
+
+func (wr *gmlWriter) writeString(depth int, key, v string) {
+	fmt.Fprintf(&wr.b, "%s%s \"%s\"\n", strings.Repeat("  ", depth), key, gmlEscaper.Replace(v))
+}
+
+// writeAttributes writes the attribute values which differ from the base values. The base value is empty if it is not in base.
+func (wr *gmlWriter) writeAttributes(depth int, kind cgraph.ObjectTag, obj attributeObject, base map[string]string) {
+	indent := strings.Repeat("  ", depth)
+	for _, name := range wr.names[kind] {
+		v := obj.GetStr(name)
+		if v == base[name] {
+			continue
+		}
+		html := obj.IsHTML(name)
+		if !html && gmlKeyPattern.MatchString(name) && !gmlReservedKeys[name] {
+			wr.writeString(depth, name, v)
+			continue
+		}
+		wr.b.WriteString(indent + "attribute [\n")
+		wr.writeString(depth+1, "name", name)
+		wr.writeString(depth+1, "value", v)
+		if html {
+			wr.writeInt(depth+1, "html", 1)
+		}
+		wr.b.WriteString(indent + "]\n")
+	}
+}
+
+// writeGroups writes the subgraphs as the group nodes recursively.
+func (wr *gmlWriter) writeGroups(g *cgraph.Graph, gid int) error {
+	subs, err := subGraphs(g)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		name, err := sub.Name()
+		if err != nil {
+			return err
+		}
+		id := wr.nextID
+		wr.nextID++
+		wr.groups[name] = id
+		wr.b.WriteString("  node [\n")
+		wr.writeInt(2, "id", id)
+		wr.writeString(2, "name", name)
+		wr.writeInt(2, "isGroup", 1)
+		if gid >= 0 {
+			wr.writeInt(2, "gid", gid)
+		}
+		wr.writeAttributes(2, cgraph.GRAPH, sub, wr.rootValues)
+		wr.b.WriteString("  ]\n")
+		if err := wr.writeGroups(sub, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (wr *gmlWriter) writeNodes(g *cgraph.Graph) error {
+	parents, err := nodeParents(g)
+	if err != nil {
+		return err
+	}
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return err
+		}
+		id := wr.nextID
+		wr.nextID++
+		wr.ids[name] = id
+		wr.b.WriteString("  node [\n")
+		wr.writeInt(2, "id", id)
+		wr.writeString(2, "name", name)
+		if parent, exists := parents[name]; exists {
+			wr.writeInt(2, "gid", wr.groups[parent])
+		}
+		wr.writeAttributes(2, cgraph.NODE, n, defaultNodeValues)
+		wr.b.WriteString("  ]\n")
+	}
+	return nil
+}
+
+func (wr *gmlWriter) writeEdges(g *cgraph.Graph) error {
+	for e, err := range g.Edges() {
+		if err != nil {
+			return err
+		}
+		tail, err := e.Tail()
+		if err != nil {
+			return err
+		}
+		head, err := e.Head()
+		if err != nil {
+			return err
+		}
+		tailName, err := tail.Name()
+		if err != nil {
+			return err
+		}
+		headName, err := head.Name()
+		if err != nil {
+			return err
+		}
+		key, err := e.Name()
+		if err != nil {
+			return err
+		}
+		wr.b.WriteString("  edge [\n")
+		wr.writeInt(2, "source", wr.ids[tailName])
+		wr.writeInt(2, "target", wr.ids[headName])
+		if key != "" {
+			wr.writeString(2, "key", key)
+		}
+		wr.writeAttributes(2, cgraph.EDGE, e, nil)
+		wr.b.WriteString("  ]\n")
+	}
+	return nil
+}
+
+func boolToInt(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
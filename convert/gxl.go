@@ -0,0 +1,417 @@
+package convert
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+var ErrGXL = errors.New("invalid GXL")
+
+const xlinkNamespace = "http://www.w3.org/1999/xlink"
+
+// The elements of GXL. http://www.gupro.de/GXL/
+type (
+	gxlDocument struct {
+		XMLName xml.Name    `xml:"gxl"`
+		XLink   string      `xml:"xmlns:xlink,attr,omitempty"`
+		Graphs  []*gxlGraph `xml:"graph"`
+	}
+	gxlGraph struct {
+		ID       string     `xml:"id,attr"`
+		EdgeMode string     `xml:"edgemode,attr,omitempty"`
+		Attrs    []*gxlAttr `xml:"attr"`
+		Nodes    []*gxlNode `xml:"node"`
+		Edges    []*gxlEdge `xml:"edge"`
+	}
+	gxlNode struct {
+		ID    string     `xml:"id,attr"`
+		Attrs []*gxlAttr `xml:"attr"`
+		Graph *gxlGraph  `xml:"graph"`
+	}
+	gxlEdge struct {
+		ID    string     `xml:"id,attr,omitempty"`
+		From  string     `xml:"from,attr"`
+		To    string     `xml:"to,attr"`
+		Attrs []*gxlAttr `xml:"attr"`
+	}
+	gxlAttr struct {
+		Name string `xml:"name,attr"`
+		// Kind is the kind of the objects of the declaration, which is written by gv2gxl for the defaults.
+		Kind  string    `xml:"kind,attr,omitempty"`
+		Value *gxlValue `xml:",any"`
+	}
+	// gxlValue is an atomic value ( bool, int, float, string, enum ), a locator or a composite value ( seq, set, bag, tup ).
+	gxlValue struct {
+		XMLName xml.Name
+		Href    string      `xml:"http://www.w3.org/1999/xlink href,attr,omitempty"`
+		Text    string      `xml:",chardata"`
+		Items   []*gxlValue `xml:",any"`
+	}
+)
+
+// String returns the value as an attribute value. Composite values are joined by ','.
+func (v *gxlValue) String() string {
+	if v == nil {
+		return ""
+	}
+	switch v.XMLName.Local {
+	case "string":
+		return v.Text
+	case "locator":
+		return v.Href
+	case "seq", "set", "bag", "tup":
+		items := make([]string, 0, len(v.Items))
+		for _, item := range v.Items {
+			items = append(items, item.String())
+		}
+		return strings.Join(items, ",")
+	}
+	return strings.TrimSpace(v.Text)
+}
+
+// isHTML reports whether the value is a locator, which gv2gxl writes for HTML strings.
+func (v *gxlValue) isHTML() bool {
+	return v != nil && v.XMLName.Local == "locator"
+}
+
+func gxlString(v string) *gxlValue {
+	return &gxlValue{XMLName: xml.Name{Local: "string"}, Text: v}
+}
+
+// gxlLocator returns the locator of the HTML string.
+func gxlLocator(v string) *gxlValue {
+	return &gxlValue{XMLName: xml.Name{Local: "locator"}, Href: v}
+}
+
+// ParseGXL reads the first graph of the GXL document like the gxl2gv tool.
+//
+// The attr elements of the top-level graph with the kind attribute are declared as the attributes with the defaults,
+// and the other attr elements are set as the attributes of the objects. Composite values are joined by ',',
+// and locators are set as HTML strings.
+// A node which has a nested graph becomes the subgraph named by the node id.
+// Edge ids are set as the id attributes of the edges.
+func ParseGXL(r io.Reader) (*cgraph.Graph, error) {
+	var doc gxlDocument
+	if err := xml.NewDecoder(r).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGXL, err)
+	}
+	if len(doc.Graphs) == 0 {
+		return nil, fmt.Errorf("%w: no graph", ErrGXL)
+	}
+	root := doc.Graphs[0]
+	// the edges of GXL are directed by default.
+	directed := root.EdgeMode != "undirected" && root.EdgeMode != "defaultundirected"
+	g, err := cgraph.Open(root.ID, graphDesc(directed), nil)
+	if err != nil {
+		return nil, err
+	}
+	p := &gxlParser{root: g}
+	if err := p.parse(root); err != nil {
+		_ = g.Close()
+		return nil, err
+	}
+	return g, nil
+}
+
+type gxlParser struct {
+	root *cgraph.Graph
+	// edges are the edges with the graphs which declare them. They are created after all nodes.
+	edges []*gxlParsedEdge
+}
+
+type gxlParsedEdge struct {
+	graph *cgraph.Graph
+	edge  *gxlEdge
+}
+
+func (p *gxlParser) parse(root *gxlGraph) error {
+	for _, attr := range root.Attrs {
+		var kind cgraph.ObjectTag
+		switch attr.Kind {
+		case "":
+			continue
+		case "graph":
+			kind = cgraph.GRAPH
+		case "node":
+			kind = cgraph.NODE
+		case "edge":
+			kind = cgraph.EDGE
+		default:
+			return fmt.Errorf("%w: unknown kind %q of attribute %s", ErrGXL, attr.Kind, attr.Name)
+		}
+		declare := p.root.Attr
+		if attr.Value.isHTML() {
+			declare = p.root.AttrHTML
+		}
+		if _, err := declare(int(kind), attr.Name, attr.Value.String()); err != nil {
+			return err
+		}
+	}
+	if err := p.parseGraph(p.root, root); err != nil {
+		return err
+	}
+	for _, e := range p.edges {
+		if err := p.createEdge(e.graph, e.edge); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *gxlParser) parseGraph(g *cgraph.Graph, graph *gxlGraph) error {
+	if err := setGXLAttributes(g, graph.Attrs); err != nil {
+		return err
+	}
+	for _, node := range graph.Nodes {
+		if node.Graph != nil {
+			sub, err := g.CreateSubGraphByName(node.ID)
+			if err != nil {
+				return err
+			}
+			if err := setGXLAttributes(sub, node.Attrs); err != nil {
+				return err
+			}
+			if err := p.parseGraph(sub, node.Graph); err != nil {
+				return err
+			}
+			continue
+		}
+		n, err := g.CreateNodeByName(node.ID)
+		if err != nil {
+			return err
+		}
+		if err := setGXLAttributes(n, node.Attrs); err != nil {
+			return err
+		}
+	}
+	for _, e := range graph.Edges {
+		p.edges = append(p.edges, &gxlParsedEdge{graph: g, edge: e})
+	}
+	return nil
+}
+
+func (p *gxlParser) createEdge(g *cgraph.Graph, edge *gxlEdge) error {
+	tail, err := p.root.NodeByName(edge.From)
+	if err != nil {
+		return err
+	}
+	head, err := p.root.NodeByName(edge.To)
+	if err != nil {
+		return err
+	}
+	if tail == nil || head == nil {
+		return fmt.Errorf("%w: edge %s -> %s refers to an unknown node", ErrGXL, edge.From, edge.To)
+	}
+	e, err := g.CreateAnonymousEdge(tail, head)
+	if err != nil {
+		return err
+	}
+	if edge.ID != "" {
+		if err := e.SafeSet("id", edge.ID, ""); err != nil {
+			return err
+		}
+	}
+	return setGXLAttributes(e, edge.Attrs)
+}
+
+// setGXLAttributes sets the attr elements except the declarations as the attributes.
+func setGXLAttributes(obj interface {
+	SafeSet(name, value, def string) error
+	SafeSetHTML(name, value, def string) error
+}, attrs []*gxlAttr) error {
+	for _, attr := range attrs {
+		if attr.Kind != "" {
+			continue
+		}
+		set := obj.SafeSet
+		if attr.Value.isHTML() {
+			set = obj.SafeSetHTML
+		}
+		if err := set(attr.Name, attr.Value.String(), ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteGXL writes the graph as a GXL document like the gv2gxl tool.
+//
+// The declared attributes are written as the attr elements of the top-level graph with the kind attribute and the defaults,
+// and the attribute values which differ from the defaults as the attr elements of the objects.
+// HTML strings are written as locators like gv2gxl.
+// Subgraphs are written as the nodes which have nested graphs, and edges are written in the top-level graph
+// with the id attributes as the ids. Graphs in Latin-1 by the charset attribute are converted to UTF-8.
+func WriteGXL(w io.Writer, g *cgraph.Graph) error {
+	wr := &gxlWriter{names: map[cgraph.ObjectTag][]string{}, defaults: map[cgraph.ObjectTag]map[string]string{}}
+	wr.latin1 = isLatin1(g.GetStr("charset"))
+	root := &gxlGraph{}
+	for _, kind := range attributeKinds {
+		wr.defaults[kind] = map[string]string{}
+		for sym, err := range g.Attributes(kind) {
+			if err != nil {
+				return err
+			}
+			name := sym.Name()
+			wr.names[kind] = append(wr.names[kind], name)
+			wr.defaults[kind][name] = sym.DefaultValue()
+			def := wr.value(sym.DefaultValue(), sym.IsHTML())
+			if wr.latin1 && kind == cgraph.GRAPH && name == "charset" {
+				def = gxlString("UTF-8")
+			}
+			root.Attrs = append(root.Attrs, &gxlAttr{Name: name, Kind: objectKindName(kind), Value: def})
+		}
+	}
+	directed, err := g.IsDirected()
+	if err != nil {
+		return err
+	}
+	wr.edgeMode = "undirected"
+	if directed {
+		wr.edgeMode = "directed"
+	}
+	name, err := g.Name()
+	if err != nil {
+		return err
+	}
+	root.ID = wr.text(name)
+	root.EdgeMode = wr.edgeMode
+	if wr.parents, err = nodeParents(g); err != nil {
+		return err
+	}
+	if err := wr.writeGraph(g, root, ""); err != nil {
+		return err
+	}
+	for e, err := range g.Edges() {
+		if err != nil {
+			return err
+		}
+		edge, err := wr.edge(e)
+		if err != nil {
+			return err
+		}
+		root.Edges = append(root.Edges, edge)
+	}
+	doc := &gxlDocument{XLink: xlinkNamespace, Graphs: []*gxlGraph{root}}
+
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+func objectKindName(kind cgraph.ObjectTag) string {
+	switch kind {
+	case cgraph.GRAPH:
+		return "graph"
+	case cgraph.NODE:
+		return "node"
+	}
+	return "edge"
+}
+
+type gxlWriter struct {
+	edgeMode string
+	names    map[cgraph.ObjectTag][]string
+	defaults map[cgraph.ObjectTag]map[string]string
+	// parents are the names of the innermost subgraphs containing the nodes.
+	parents map[string]string
+	// latin1 is true if the strings of the graph are encoded in Latin-1 by the charset attribute.
+	latin1 bool
+}
+
+// text returns the string in UTF-8 because XML documents cannot have Latin-1 strings.
+func (wr *gxlWriter) text(s string) string {
+	if !wr.latin1 {
+		return s
+	}
+	return latin1ToUTF8(s)
+}
+
+// value returns the attribute value as a string, or a locator if it is an HTML string.
+func (wr *gxlWriter) value(v string, html bool) *gxlValue {
+	if html {
+		return gxlLocator(wr.text(v))
+	}
+	return gxlString(wr.text(v))
+}
+
+// writeGraph writes the subgraphs and the nodes whose innermost subgraph is g. parent is empty for the root graph.
+func (wr *gxlWriter) writeGraph(g *cgraph.Graph, graph *gxlGraph, parent string) error {
+	subs, err := subGraphs(g)
+	if err != nil {
+		return err
+	}
+	for _, sub := range subs {
+		name, err := sub.Name()
+		if err != nil {
+			return err
+		}
+		nested := &gxlGraph{ID: wr.text(name) + ":", EdgeMode: wr.edgeMode}
+		if err := wr.writeGraph(sub, nested, name); err != nil {
+			return err
+		}
+		graph.Nodes = append(graph.Nodes, &gxlNode{ID: wr.text(name), Attrs: wr.attrs(cgraph.GRAPH, sub), Graph: nested})
+	}
+	for n, err := range g.Nodes() {
+		if err != nil {
+			return err
+		}
+		name, err := n.Name()
+		if err != nil {
+			return err
+		}
+		if wr.parents[name] != parent {
+			continue
+		}
+		graph.Nodes = append(graph.Nodes, &gxlNode{ID: wr.text(name), Attrs: wr.attrs(cgraph.NODE, n)})
+	}
+	return nil
+}
+
+func (wr *gxlWriter) edge(e *cgraph.Edge) (*gxlEdge, error) {
+	tail, err := e.Tail()
+	if err != nil {
+		return nil, err
+	}
+	head, err := e.Head()
+	if err != nil {
+		return nil, err
+	}
+	edge := &gxlEdge{ID: e.GetStr("id")}
+	for _, attr := range wr.attrs(cgraph.EDGE, e) {
+		// The id attribute is written as the id of the edge.
+		if attr.Name != "id" || edge.ID == "" {
+			edge.Attrs = append(edge.Attrs, attr)
+		}
+	}
+	if edge.From, err = tail.Name(); err != nil {
+		return nil, err
+	}
+	if edge.To, err = head.Name(); err != nil {
+		return nil, err
+	}
+	edge.From, edge.To, edge.ID = wr.text(edge.From), wr.text(edge.To), wr.text(edge.ID)
+	return edge, nil
+}
+
+// attrs returns the attribute values which differ from the defaults.
+func (wr *gxlWriter) attrs(kind cgraph.ObjectTag, obj attributeObject) []*gxlAttr {
+	var attrs []*gxlAttr
+	for _, name := range wr.names[kind] {
+		if v := obj.GetStr(name); v != wr.defaults[kind][name] {
+			attrs = append(attrs, &gxlAttr{Name: name, Value: wr.value(v, obj.IsHTML(name))})
+		}
+	}
+	return attrs
+}
@@ -0,0 +1,214 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// mermaidShapes are the node shapes of Mermaid flowcharts by the shapes of Graphviz as the opening and closing brackets.
+// Other shapes are reported and drawn as rectangles.
+var mermaidShapes = map[cgraph.Shape][2]string{
+	cgraph.BoxShape:           {"[", "]"},
+	cgraph.RectShape:          {"[", "]"},
+	cgraph.RectangleShape:     {"[", "]"},
+	cgraph.SquareShape:        {"[", "]"},
+	cgraph.PlainTextShape:     {"[", "]"},
+	cgraph.PlainShape:         {"[", "]"},
+	cgraph.NoneShape:          {"[", "]"},
+	cgraph.RecordShape:        {"[", "]"},
+	cgraph.MrecordShape:       {"(", ")"},
+	cgraph.EllipseShape:       {"([", "])"},
+	cgraph.OvalShape:          {"([", "])"},
+	cgraph.EggShape:           {"([", "])"},
+	cgraph.CircleShape:        {"((", "))"},
+	cgraph.PointShape:         {"((", "))"},
+	cgraph.DoubleCircleShape:  {"(((", ")))"},
+	cgraph.DiamondShape:       {"{", "}"},
+	cgraph.MdiamondShape:      {"{", "}"},
+	cgraph.HexagonShape:       {"{{", "}}"},
+	cgraph.ParallelogramShape: {"[/", "/]"},
+	cgraph.TrapeziumShape:     {"[/", `\]`},
+	cgraph.InvTrapeziumShape:  {`[\`, "/]"},
+	cgraph.CylinderShape:      {"[(", ")]"},
+	cgraph.Box3DShape:         {"[[", "]]"},
+	cgraph.ComponentShape:     {"[[", "]]"},
+	cgraph.MsquareShape:       {"[[", "]]"},
+	cgraph.CdsShape:           {">", "]"},
+	cgraph.RArrowShape:        {">", "]"},
+}
+
+// mermaidDirections are the directions of Mermaid flowcharts by rankdir.
+var mermaidDirections = map[cgraph.RankDir]string{
+	cgraph.TBRank: "TD",
+	cgraph.LRRank: "LR",
+	cgraph.BTRank: "BT",
+	cgraph.RLRank: "RL",
+}
+
+var mermaidEscaper = strings.NewReplacer("#", "#35;", `"`, "#quot;", "<", "#lt;", ">", "#gt;", "\n", "<br>")
+
+// WriteMermaid writes the graph as a Mermaid flowchart and returns the attribute values which cannot be represented.
+// https://mermaid.js.org/syntax/flowchart.html
+//
+// rankdir is mapped to the direction, the label of the graph to the title, cluster subgraphs to subgraphs,
+// and the shapes of nodes to the similar shapes of Mermaid. The colors, pen widths and styles of nodes,
+// clusters and edges are written as style and linkStyle statements. Other attributes are reported.
+func WriteMermaid(w io.Writer, g *cgraph.Graph) ([]*Unsupported, error) {
+	eg, err := newExportGraph(g)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	if eg.label != "" {
+		fmt.Fprintf(&b, "---\ntitle: %q\n---\n", eg.label)
+	}
+	fmt.Fprintf(&b, "flowchart %s\n", mermaidDirections[eg.rankdir])
+	for _, n := range eg.nodes {
+		if n.cluster == nil {
+			writeMermaidNode(&b, eg, n, 1)
+		}
+	}
+	for _, c := range eg.clusters {
+		writeMermaidCluster(&b, eg, c, 1)
+	}
+	var styles []string
+	for i, e := range eg.edges {
+		writeMermaidEdge(&b, eg, e)
+		if style := mermaidStyle(e.style, true); style != "" {
+			styles = append(styles, fmt.Sprintf("linkStyle %d %s", i, style))
+		}
+	}
+	for _, n := range eg.nodes {
+		if n.style.invisible {
+			eg.report(n.object, "style", "invis")
+		}
+		style := mermaidStyle(n.style, false)
+		switch n.shape {
+		case cgraph.PlainTextShape, cgraph.PlainShape, cgraph.NoneShape:
+			// the shapes without the outlines.
+			style = strings.TrimPrefix(style+",fill:none,stroke:none", ",")
+		}
+		if style != "" {
+			styles = append(styles, fmt.Sprintf("style %s %s", n.id, style))
+		}
+	}
+	var clusterStyles func([]*exportCluster)
+	clusterStyles = func(clusters []*exportCluster) {
+		for _, c := range clusters {
+			if style := mermaidStyle(c.style, false); style != "" {
+				styles = append(styles, fmt.Sprintf("style %s %s", c.id, style))
+			}
+			clusterStyles(c.clusters)
+		}
+	}
+	clusterStyles(eg.clusters)
+	for _, style := range styles {
+		fmt.Fprintf(&b, "    %s\n", style)
+	}
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return nil, err
+	}
+	return eg.unsupported, nil
+}
+
+func writeMermaidNode(b *strings.Builder, eg *exportGraph, n *exportNode, depth int) {
+	brackets, exists := mermaidShapes[n.shape]
+	if !exists {
+		eg.report(n.object, "shape", string(n.shape))
+		brackets = mermaidShapes[cgraph.BoxShape]
+	}
+	if n.style.rounded && brackets == mermaidShapes[cgraph.BoxShape] {
+		brackets = mermaidShapes[cgraph.MrecordShape]
+	}
+	label := n.label
+	if label == "" {
+		// an empty quoted text is not allowed.
+		label = " "
+	}
+	fmt.Fprintf(b, "%s%s%s\"%s\"%s\n", strings.Repeat("    ", depth), n.id, brackets[0], mermaidEscaper.Replace(label), brackets[1])
+}
+
+func writeMermaidCluster(b *strings.Builder, eg *exportGraph, c *exportCluster, depth int) {
+	indent := strings.Repeat("    ", depth)
+	label := c.label
+	if label == "" {
+		label = " "
+	}
+	fmt.Fprintf(b, "%ssubgraph %s[\"%s\"]\n", indent, c.id, mermaidEscaper.Replace(label))
+	for _, n := range c.nodes {
+		writeMermaidNode(b, eg, n, depth+1)
+	}
+	for _, child := range c.clusters {
+		writeMermaidCluster(b, eg, child, depth+1)
+	}
+	fmt.Fprintf(b, "%send\n", indent)
+}
+
+func writeMermaidEdge(b *strings.Builder, eg *exportGraph, e *exportEdge) {
+	tail, head := e.tail, e.head
+	// Mermaid draws the arrows at the heads, so the edges of dir=back are reversed.
+	var left, right string
+	switch e.dir {
+	case cgraph.ForwardDir:
+		right = ">"
+	case cgraph.BackDir:
+		tail, head = head, tail
+		right = ">"
+	case cgraph.BothDir:
+		left, right = "<", ">"
+	}
+	// the lines without arrows need three characters such as "---".
+	line := "--"
+	switch {
+	case e.style.invisible:
+		line, left, right = "~~~", "", ""
+	case e.style.dashed || e.style.dotted:
+		line = "-.-"
+	case e.style.bold:
+		line = "=="
+		if right == "" {
+			line = "==="
+		}
+	case right == "":
+		line = "---"
+	}
+	op := left + line + right
+	if e.label != "" && !e.style.invisible {
+		op += "|\"" + mermaidEscaper.Replace(e.label) + "\"|"
+	}
+	fmt.Fprintf(b, "    %s %s %s\n", tail.id, op, head.id)
+}
+
+// mermaidStyle returns the CSS properties of the style or linkStyle statement.
+func mermaidStyle(s exportStyle, edge bool) string {
+	var props []string
+	if s.filled && s.fillColor != "" {
+		props = append(props, "fill:"+s.fillColor)
+	}
+	if s.color != "" {
+		props = append(props, "stroke:"+s.color)
+	}
+	if s.fontColor != "" {
+		props = append(props, "color:"+s.fontColor)
+	}
+	switch {
+	case s.penWidth > 0:
+		props = append(props, fmt.Sprintf("stroke-width:%gpx", s.penWidth))
+	case s.bold:
+		props = append(props, "stroke-width:2px")
+	}
+	if edge {
+		// the line styles of edges are written by the link operators.
+		return strings.Join(props, ",")
+	}
+	switch {
+	case s.dashed:
+		props = append(props, "stroke-dasharray:5 5")
+	case s.dotted:
+		props = append(props, "stroke-dasharray:1 3")
+	}
+	return strings.Join(props, ",")
+}
@@ -0,0 +1,367 @@
+package convert
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// PlantUMLDiagram is the kind of PlantUML diagrams written by WritePlantUML.
+type PlantUMLDiagram string
+
+const (
+	// PlantUMLComponent writes the nodes as the elements of a component diagram.
+	// https://plantuml.com/component-diagram
+	PlantUMLComponent PlantUMLDiagram = "component"
+	// PlantUMLActivity writes the nodes as the activities of an activity diagram in the legacy syntax.
+	// https://plantuml.com/activity-diagram-legacy
+	PlantUMLActivity PlantUMLDiagram = "activity"
+)
+
+// plantUMLElements are the element keywords of component diagrams by the shapes of Graphviz.
+// Other shapes are reported and drawn as rectangles.
+var plantUMLElements = map[cgraph.Shape]string{
+	cgraph.BoxShape:          "rectangle",
+	cgraph.RectShape:         "rectangle",
+	cgraph.RectangleShape:    "rectangle",
+	cgraph.SquareShape:       "rectangle",
+	cgraph.MsquareShape:      "rectangle",
+	cgraph.RecordShape:       "rectangle",
+	cgraph.MrecordShape:      "rectangle",
+	cgraph.PlainTextShape:    "label",
+	cgraph.PlainShape:        "label",
+	cgraph.NoneShape:         "label",
+	cgraph.EllipseShape:      "usecase",
+	cgraph.OvalShape:         "usecase",
+	cgraph.EggShape:          "usecase",
+	cgraph.CircleShape:       "circle",
+	cgraph.DoubleCircleShape: "circle",
+	cgraph.McircleShape:      "circle",
+	cgraph.PointShape:        "circle",
+	cgraph.HexagonShape:      "hexagon",
+	cgraph.CylinderShape:     "database",
+	cgraph.ComponentShape:    "component",
+	cgraph.FolderShape:       "folder",
+	cgraph.TabShape:          "folder",
+	cgraph.NoteShape:         "file",
+	cgraph.Box3DShape:        "node",
+}
+
+// plantUMLActivityShapes are the shapes drawn as activities. Point nodes are the start and end points.
+var plantUMLActivityShapes = map[cgraph.Shape]bool{
+	cgraph.BoxShape:       true,
+	cgraph.RectShape:      true,
+	cgraph.RectangleShape: true,
+	cgraph.MrecordShape:   true,
+	cgraph.EllipseShape:   true,
+	cgraph.OvalShape:      true,
+	cgraph.PointShape:     true,
+}
+
+var plantUMLEscaper = strings.NewReplacer(`"`, "&#34;", "\n", `\n`)
+
+// WritePlantUML writes the graph as a PlantUML diagram and returns the attribute values which cannot be represented.
+//
+// For component diagrams, rankdir=LR is mapped to the left to right direction, the label of the graph to the title,
+// cluster subgraphs to rectangles containing the elements, and the shapes of nodes to the similar elements.
+// The colors, line styles and pen widths of the elements and the arrows are written inline.
+// Activity diagrams are drawn from the edges, so top-level clusters are mapped to partitions
+// and point nodes to the start and end points, and isolated nodes and nested clusters are reported.
+func WritePlantUML(w io.Writer, g *cgraph.Graph, diagram PlantUMLDiagram) ([]*Unsupported, error) {
+	switch diagram {
+	case PlantUMLComponent, PlantUMLActivity:
+	default:
+		return nil, fmt.Errorf("unknown PlantUML diagram %q", diagram)
+	}
+	eg, err := newExportGraph(g)
+	if err != nil {
+		return nil, err
+	}
+	var b strings.Builder
+	b.WriteString("@startuml\n")
+	if eg.label != "" {
+		fmt.Fprintf(&b, "title %s\n", plantUMLEscaper.Replace(eg.label))
+	}
+	if diagram == PlantUMLComponent {
+		writePlantUMLComponent(&b, eg)
+	} else {
+		writePlantUMLActivity(&b, eg)
+	}
+	b.WriteString("@enduml\n")
+	if _, err := io.WriteString(w, b.String()); err != nil {
+		return nil, err
+	}
+	return eg.unsupported, nil
+}
+
+func writePlantUMLComponent(b *strings.Builder, eg *exportGraph) {
+	switch eg.rankdir {
+	case cgraph.TBRank:
+	case cgraph.LRRank:
+		b.WriteString("left to right direction\n")
+	default:
+		eg.report(eg.object, "rankdir", string(eg.rankdir))
+	}
+	for _, n := range eg.nodes {
+		if n.cluster == nil {
+			writePlantUMLElement(b, eg, n, 0)
+		}
+	}
+	for _, c := range eg.clusters {
+		writePlantUMLCluster(b, eg, c, 0)
+	}
+	for _, e := range eg.edges {
+		tail, head := e.tail, e.head
+		var left, right string
+		switch e.dir {
+		case cgraph.ForwardDir:
+			right = ">"
+		case cgraph.BackDir:
+			left = "<"
+		case cgraph.BothDir:
+			left, right = "<", ">"
+		}
+		var props []string
+		if e.style.color != "" {
+			props = append(props, e.style.color)
+		}
+		switch {
+		case e.style.invisible:
+			props = append(props, "hidden")
+		case e.style.dashed:
+			props = append(props, "dashed")
+		case e.style.dotted:
+			props = append(props, "dotted")
+		}
+		switch {
+		case e.style.penWidth > 0:
+			props = append(props, fmt.Sprintf("thickness=%g", e.style.penWidth))
+		case e.style.bold:
+			props = append(props, "bold")
+		}
+		if e.style.fontColor != "" {
+			eg.report(e.object, "fontcolor", e.style.fontColor)
+		}
+		line := "--"
+		if len(props) > 0 {
+			line = "-[" + strings.Join(props, ",") + "]-"
+		}
+		fmt.Fprintf(b, "%s %s%s%s %s", tail.id, left, line, right, head.id)
+		if e.label != "" {
+			fmt.Fprintf(b, " : %s", plantUMLEscaper.Replace(e.label))
+		}
+		b.WriteString("\n")
+	}
+}
+
+func writePlantUMLElement(b *strings.Builder, eg *exportGraph, n *exportNode, depth int) {
+	element, exists := plantUMLElements[n.shape]
+	if !exists {
+		eg.report(n.object, "shape", string(n.shape))
+		element = "rectangle"
+	}
+	if n.style.rounded {
+		eg.report(n.object, "style", "rounded")
+	}
+	if n.style.invisible {
+		eg.report(n.object, "style", "invis")
+	}
+	fmt.Fprintf(b, "%s%s \"%s\" as %s%s\n", strings.Repeat("  ", depth), element, plantUMLEscaper.Replace(n.label), n.id, plantUMLColors(eg, n.object, n.style))
+}
+
+func writePlantUMLCluster(b *strings.Builder, eg *exportGraph, c *exportCluster, depth int) {
+	indent := strings.Repeat("  ", depth)
+	label := c.label
+	if label == "" {
+		label = " "
+	}
+	if c.style.rounded {
+		eg.report(c.object, "style", "rounded")
+	}
+	fmt.Fprintf(b, "%srectangle \"%s\" as %s%s {\n", indent, plantUMLEscaper.Replace(label), c.id, plantUMLColors(eg, c.object, c.style))
+	for _, n := range c.nodes {
+		writePlantUMLElement(b, eg, n, depth+1)
+	}
+	for _, child := range c.clusters {
+		writePlantUMLCluster(b, eg, child, depth+1)
+	}
+	fmt.Fprintf(b, "%s}\n", indent)
+}
+
+// plantUMLColors returns the inline colors and line style of the element such as " #ffffff;line:ff0000;line.dashed".
+func plantUMLColors(eg *exportGraph, object string, s exportStyle) string {
+	var props []string
+	if s.filled && s.fillColor != "" {
+		props = append(props, strings.TrimPrefix(s.fillColor, "#"))
+	}
+	if s.color != "" {
+		props = append(props, "line:"+strings.TrimPrefix(s.color, "#"))
+	}
+	if s.fontColor != "" {
+		props = append(props, "text:"+strings.TrimPrefix(s.fontColor, "#"))
+	}
+	switch {
+	case s.dashed:
+		props = append(props, "line.dashed")
+	case s.dotted:
+		props = append(props, "line.dotted")
+	}
+	switch {
+	case s.bold || s.penWidth >= 2:
+		props = append(props, "line.bold")
+	case s.penWidth > 0 && s.penWidth != 1:
+		eg.report(object, "penwidth", fmt.Sprint(s.penWidth))
+	}
+	if len(props) == 0 {
+		return ""
+	}
+	return " #" + strings.Join(props, ";")
+}
+
+func writePlantUMLActivity(b *strings.Builder, eg *exportGraph) {
+	if eg.rankdir != cgraph.TBRank {
+		eg.report(eg.object, "rankdir", string(eg.rankdir))
+	}
+	// partition returns the top-level cluster of the node where the activity is drawn.
+	partition := func(n *exportNode) *exportCluster {
+		c := n.cluster
+		for c != nil && c.parent != nil {
+			c = c.parent
+		}
+		return c
+	}
+	var nested func([]*exportCluster)
+	nested = func(clusters []*exportCluster) {
+		for _, c := range clusters {
+			eg.report(c.object, "", "nested cluster")
+			nested(c.clusters)
+		}
+	}
+	for _, c := range eg.clusters {
+		nested(c.clusters)
+	}
+	declared := map[*exportNode]bool{}
+	var current *exportCluster
+	activity := func(n *exportNode) string {
+		if n.shape == cgraph.PointShape {
+			return "(*)"
+		}
+		if declared[n] {
+			return n.id
+		}
+		declared[n] = true
+		if p := partition(n); p != nil && p != current {
+			eg.report(n.object, "", "node outside its partition")
+		}
+		return fmt.Sprintf("\"%s\" as %s", plantUMLEscaper.Replace(n.label), n.id)
+	}
+	writeEdge := func(e *exportEdge, indent string) {
+		tail, head := e.tail, e.head
+		switch e.dir {
+		case cgraph.BackDir:
+			tail, head = head, tail
+		case cgraph.BothDir, cgraph.NoneDir:
+			eg.report(e.object, "dir", string(e.dir))
+		}
+		var props []string
+		if e.style.color != "" {
+			props = append(props, e.style.color)
+		}
+		switch {
+		case e.style.invisible:
+			props = append(props, "hidden")
+		case e.style.dashed:
+			props = append(props, "dashed")
+		case e.style.dotted:
+			props = append(props, "dotted")
+		}
+		if e.style.bold || e.style.penWidth > 0 {
+			props = append(props, "bold")
+		}
+		if e.style.fontColor != "" {
+			eg.report(e.object, "fontcolor", e.style.fontColor)
+		}
+		line := "-->"
+		if len(props) > 0 {
+			line = "-[" + strings.Join(props, ",") + "]->"
+		}
+		if e.label != "" {
+			line += "[" + strings.ReplaceAll(plantUMLEscaper.Replace(e.label), "]", "&#93;") + "]"
+		}
+		fmt.Fprintf(b, "%s%s %s %s\n", indent, activity(tail), line, activity(head))
+	}
+	// the edges inside the partitions are written first to declare the activities in their partitions.
+	written := map[*exportEdge]bool{}
+	for _, c := range eg.clusters {
+		current = c
+		var edges []*exportEdge
+		for _, e := range eg.edges {
+			if partition(e.tail) == c && partition(e.head) == c {
+				edges = append(edges, e)
+			}
+		}
+		label := c.label
+		if label == "" {
+			label = " "
+		}
+		fmt.Fprintf(b, "partition \"%s\"%s {\n", plantUMLEscaper.Replace(label), plantUMLPartitionColor(eg, c))
+		for _, e := range edges {
+			writeEdge(e, "  ")
+			written[e] = true
+		}
+		b.WriteString("}\n")
+	}
+	current = nil
+	for _, e := range eg.edges {
+		if !written[e] {
+			writeEdge(e, "")
+		}
+	}
+	for _, n := range eg.nodes {
+		if !plantUMLActivityShapes[n.shape] {
+			eg.report(n.object, "shape", string(n.shape))
+		}
+		if !declared[n] && n.shape != cgraph.PointShape {
+			eg.report(n.object, "", "isolated node")
+		}
+		reportStyle(eg, n.object, n.style)
+	}
+}
+
+// plantUMLPartitionColor returns the background color of the partition. Other styles are reported.
+func plantUMLPartitionColor(eg *exportGraph, c *exportCluster) string {
+	s := c.style
+	var color string
+	if s.filled && s.fillColor != "" {
+		color = " " + s.fillColor
+	}
+	s.filled, s.fillColor = false, ""
+	reportStyle(eg, c.object, s)
+	return color
+}
+
+// reportStyle reports the attributes of the style which are set.
+func reportStyle(eg *exportGraph, object string, s exportStyle) {
+	if s.color != "" {
+		eg.report(object, "color", s.color)
+	}
+	if s.filled && s.fillColor != "" {
+		eg.report(object, "fillcolor", s.fillColor)
+	}
+	if s.fontColor != "" {
+		eg.report(object, "fontcolor", s.fontColor)
+	}
+	if s.penWidth > 0 {
+		eg.report(object, "penwidth", fmt.Sprint(s.penWidth))
+	}
+	for _, style := range []struct {
+		set  bool
+		name string
+	}{{s.filled, "filled"}, {s.dashed, "dashed"}, {s.dotted, "dotted"}, {s.bold, "bold"}, {s.rounded, "rounded"}, {s.invisible, "invis"}} {
+		if style.set {
+			eg.report(object, "style", style.name)
+		}
+	}
+}
@@ -2,9 +2,11 @@ package graphviz
 
 import (
 	"context"
+	"fmt"
 	"image"
 	"io"
 	"io/fs"
+	"strings"
 
 	"github.com/goccy/go-graphviz/cgraph"
 	"github.com/goccy/go-graphviz/gvc"
@@ -12,10 +14,11 @@ import (
 )
 
 type Graphviz struct {
-	ctx    *gvc.Context
-	name   string
-	dir    *GraphDescriptor
-	layout Layout
+	ctx       *gvc.Context
+	name      string
+	dir       *GraphDescriptor
+	layout    Layout
+	selection *LayoutSelection
 }
 
 type Layout string
@@ -37,10 +40,15 @@ const (
 type Format string
 
 const (
-	XDOT Format = "dot"
-	SVG  Format = "svg"
-	PNG  Format = "png"
-	JPG  Format = "jpg"
+	XDOT     Format = "dot"
+	SVG      Format = "svg"
+	PNG      Format = "png"
+	JPG      Format = "jpg"
+	DRAWLIST Format = "drawlist"
+	TEXT     Format = "text"
+	ASCII    Format = "ascii"
+	SIXEL    Format = "sixel"
+	KITTY    Format = "kitty"
 )
 
 func New(ctx context.Context) (*Graphviz, error) {
@@ -76,53 +84,111 @@ func (g *Graphviz) SetLayout(layout Layout) *Graphviz {
 	return g
 }
 
-func (g *Graphviz) Render(ctx context.Context, graph *Graph, format Format, w io.Writer) (e error) {
-	defer func() {
-		if err := g.ctx.FreeLayout(ctx, graph); err != nil {
-			e = err
-		}
-	}()
+// IgnoredAttributes returns the attributes declared in the graph which have no effect with the current layout engine.
+func (g *Graphviz) IgnoredAttributes(graph *Graph) ([]*cgraph.AttributeWarning, error) {
+	s, err := g.layoutEngine(graph)
+	if err != nil {
+		return nil, err
+	}
+	return graph.IgnoredAttributes(string(s.Layout))
+}
+
+func (g *Graphviz) Render(ctx context.Context, graph *Graph, format Format, w io.Writer) error {
+	return g.withLayout(ctx, graph, func(graph *Graph) error {
+		return g.ctx.RenderData(ctx, graph, string(format), w)
+	})
+}
 
-	if err := g.ctx.Layout(ctx, graph, string(g.layout)); err != nil {
+func (g *Graphviz) RenderImage(ctx context.Context, graph *Graph) (image.Image, error) {
+	var img image.Image
+	if err := g.withLayout(ctx, graph, func(graph *Graph) error {
+		var err error
+		img, err = g.ctx.RenderImage(ctx, graph, string(PNG))
 		return err
+	}); err != nil {
+		return nil, err
 	}
-	if err := g.ctx.RenderData(ctx, graph, string(format), w); err != nil {
+	return img, nil
+}
+
+// RenderImages renders the graph as PNG and returns every page.
+// The graph is split into multiple pages if the page attribute is specified.
+func (g *Graphviz) RenderImages(ctx context.Context, graph *Graph) ([]image.Image, error) {
+	var images []image.Image
+	if err := g.withLayout(ctx, graph, func(graph *Graph) error {
+		var err error
+		images, err = g.ctx.RenderImages(ctx, graph, string(PNG))
 		return err
+	}); err != nil {
+		return nil, err
 	}
-	return nil
+	return images, nil
 }
 
-func (g *Graphviz) RenderImage(ctx context.Context, graph *Graph) (img image.Image, e error) {
-	defer func() {
-		if err := g.ctx.FreeLayout(ctx, graph); err != nil {
-			e = err
-		}
-	}()
+// RenderLayer renders only the objects belonging to layer.
+// layer is a name or a number of the layers attribute and applied as the layerselect attribute of a copy of the graph,
+// so the graph itself is not modified.
+func (g *Graphviz) RenderLayer(ctx context.Context, graph *Graph, format Format, layer string, w io.Writer) error {
+	selected, err := graph.Clone()
+	if err != nil {
+		return err
+	}
+	defer selected.Close()
+	if err := selected.SafeSet("layerselect", layer, ""); err != nil {
+		return err
+	}
+	return g.Render(ctx, selected, format, w)
+}
 
-	if err := g.ctx.Layout(ctx, graph, string(g.layout)); err != nil {
-		return nil, err
+// RenderLayers renders every layer of the layers attribute as PNG with a single layout pass
+// and returns the images by the layer names.
+// If the layerselect attribute is set, a copy of the graph without it is rendered, so the graph itself is not modified.
+// Graphs split into multiple pages by the page attribute cannot be rendered by layers.
+func (g *Graphviz) RenderLayers(ctx context.Context, graph *Graph) (map[string]image.Image, error) {
+	names := layerNames(graph)
+	if len(names) == 0 {
+		return nil, fmt.Errorf("graph has no layers")
+	}
+	if graph.GetStr("layerselect") != "" {
+		all, err := graph.Clone()
+		if err != nil {
+			return nil, err
+		}
+		defer all.Close()
+		if err := all.SafeSet("layerselect", "", ""); err != nil {
+			return nil, err
+		}
+		graph = all
 	}
-	image, err := g.ctx.RenderImage(ctx, graph, string(PNG))
+	images, err := g.RenderImages(ctx, graph)
 	if err != nil {
 		return nil, err
 	}
-	return image, nil
+	if len(images) != len(names) {
+		return nil, fmt.Errorf("expected an image per layer but got %d images for %d layers", len(images), len(names))
+	}
+	layers := make(map[string]image.Image, len(names))
+	for i, name := range names {
+		layers[name] = images[i]
+	}
+	return layers, nil
 }
 
-func (g *Graphviz) RenderFilename(ctx context.Context, graph *Graph, format Format, path string) (e error) {
-	defer func() {
-		if err := g.ctx.FreeLayout(ctx, graph); err != nil {
-			e = err
-		}
-	}()
-
-	if err := g.ctx.Layout(ctx, graph, string(g.layout)); err != nil {
-		return err
+// layerNames returns the names of the layers attribute separated by the characters of the layersep attribute.
+func layerNames(graph *Graph) []string {
+	sep := graph.GetStr("layersep")
+	if sep == "" {
+		sep = ":\t "
 	}
-	if err := g.ctx.RenderFilename(ctx, graph, string(format), path); err != nil {
-		return err
-	}
-	return nil
+	return strings.FieldsFunc(graph.GetStr("layers"), func(r rune) bool {
+		return strings.ContainsRune(sep, r)
+	})
+}
+
+func (g *Graphviz) RenderFilename(ctx context.Context, graph *Graph, format Format, path string) error {
+	return g.withLayout(ctx, graph, func(graph *Graph) error {
+		return g.ctx.RenderFilename(ctx, graph, string(format), path)
+	})
 }
 
 func (g *Graphviz) Graph(option ...GraphOption) (*Graph, error) {
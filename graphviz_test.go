@@ -4,12 +4,27 @@ import (
 	"bytes"
 	"context"
 	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/jpeg"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
 	"github.com/goccy/go-graphviz"
+	"github.com/goccy/go-graphviz/cgraph"
+	"github.com/goccy/go-graphviz/cgraph/htmllabel"
+	"github.com/goccy/go-graphviz/cgraph/recordlabel"
+	"github.com/goccy/go-graphviz/convert"
+	"github.com/goccy/go-graphviz/gvpr"
 )
 
 func TestGraphviz_Image(t *testing.T) {
@@ -350,3 +365,2321 @@ func TestEdgeSourceAndTarget(t *testing.T) {
 		t.Fatalf("Expected target name to be 'a', got '%s'", tailName)
 	}
 }
+
+type testObjectRenderer struct {
+	begin []string
+	end   map[string]*graphviz.RenderObject
+}
+
+func (r *testObjectRenderer) BeginObject(_ context.Context, _ *graphviz.Job, obj *graphviz.RenderObject) error {
+	r.begin = append(r.begin, obj.Name)
+	return nil
+}
+
+func (r *testObjectRenderer) EndObject(_ context.Context, _ *graphviz.Job, obj *graphviz.RenderObject) error {
+	r.end[obj.Name] = obj
+	return nil
+}
+
+func TestObjectRenderer(t *testing.T) {
+	ctx := context.Background()
+	renderer := &testObjectRenderer{end: map[string]*graphviz.RenderObject{}}
+	renderPlugin, err := graphviz.NewRenderPlugin(ctx, "objects", graphviz.NewObjectRenderEngine(renderer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	devicePlugin, err := graphviz.NewDevicePlugin(ctx, "objects:objects")
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := graphviz.NewWithPlugins(ctx, renderPlugin, devicePlugin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+
+	graph, err := graphviz.ParseBytes([]byte(`digraph G {
+  subgraph cluster_0 { label="c0"; a [class="service" shape=box] }
+  a -> b [color=red]
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	var buf bytes.Buffer
+	if err := g.Render(ctx, graph, "objects", &buf); err != nil {
+		t.Fatal(err)
+	}
+	if len(renderer.begin) == 0 || renderer.begin[0] != "G" {
+		t.Fatalf("expected root graph to be rendered first. but got %v", renderer.begin)
+	}
+	for _, name := range []string{"G", "cluster_0", "a", "b", "a -> b"} {
+		obj, exists := renderer.end[name]
+		if !exists {
+			t.Fatalf("failed to find %q object", name)
+		}
+		if obj.BoundingBox.Empty() {
+			t.Fatalf("expected non-empty bounding box for %q", name)
+		}
+	}
+	a := renderer.end["a"]
+	if a.Type != graphviz.NodeObjectType {
+		t.Fatalf("unexpected object type %v", a.Type)
+	}
+	if a.Class != "service" {
+		t.Fatalf("expected class is service. but got %q", a.Class)
+	}
+	if a.Attributes["shape"] != "box" {
+		t.Fatalf("expected shape is box. but got %q", a.Attributes["shape"])
+	}
+	e := renderer.end["a -> b"]
+	if e.Type != graphviz.EdgeObjectType || e.Attributes["color"] != "red" {
+		t.Fatalf("unexpected edge object %+v", e)
+	}
+	if renderer.end["cluster_0"].Type != graphviz.ClusterObjectType {
+		t.Fatalf("unexpected cluster object type")
+	}
+	root := renderer.end["G"].BoundingBox
+	for _, name := range []string{"cluster_0", "a", "b", "a -> b"} {
+		box := renderer.end[name].BoundingBox
+		if box.LLX < root.LLX || box.LLY < root.LLY || box.URX > root.URX || box.URY > root.URY {
+			t.Fatalf("expected %q bounding box %+v to be inside of %+v", name, box, root)
+		}
+	}
+}
+
+func TestDrawList(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { a [id="node-a" style=filled fillcolor=yellow]; a -> b [label="e" style=dashed] }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	var buf bytes.Buffer
+	if err := g.Render(ctx, graph, graphviz.DRAWLIST, &buf); err != nil {
+		t.Fatal(err)
+	}
+	var list graphviz.DrawList
+	if err := json.Unmarshal(buf.Bytes(), &list); err != nil {
+		t.Fatalf("failed to unmarshal drawlist: %v: %s", err, buf.String())
+	}
+	if list.Width == 0 || list.Height == 0 {
+		t.Fatalf("unexpected canvas size %fx%f", list.Width, list.Height)
+	}
+	objects := map[string]*graphviz.DrawObject{}
+	for _, o := range list.Objects {
+		objects[o.Kind+":"+o.Name] = o
+	}
+	a := objects["node:a"]
+	if a == nil {
+		t.Fatalf("failed to find node a: %s", buf.String())
+	}
+	if a.ID != "node-a" {
+		t.Fatalf("expected id is node-a. but got %q", a.ID)
+	}
+	if len(a.Primitives) == 0 || a.Primitives[0].Type != graphviz.DrawPrimitiveEllipse {
+		t.Fatalf("unexpected primitives %+v", a.Primitives)
+	}
+	if !a.Primitives[0].Filled || a.Primitives[0].FillColor != "#ffff00ff" {
+		t.Fatalf("unexpected fill %+v", a.Primitives[0])
+	}
+	if len(a.Texts) != 1 || a.Texts[0].Text != "a" || a.Texts[0].Font.Size == 0 {
+		t.Fatalf("unexpected texts %+v", a.Texts)
+	}
+	for _, v := range a.BoundingBox {
+		if v < 0 || v > list.Width+list.Height {
+			t.Fatalf("bounding box is out of canvas: %v", a.BoundingBox)
+		}
+	}
+	e := objects["edge:a -> b"]
+	if e == nil {
+		t.Fatalf("failed to find edge: %s", buf.String())
+	}
+	if e.Primitives[0].Type != graphviz.DrawPrimitiveBezier || e.Primitives[0].Pen != "dashed" {
+		t.Fatalf("unexpected edge primitive %+v", e.Primitives[0])
+	}
+}
+
+func TestTerminalFormats(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { node [shape=box]; alpha -> beta }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	for _, test := range []struct {
+		format   graphviz.Format
+		contains []string
+	}{
+		{format: graphviz.TEXT, contains: []string{"alpha", "beta", "┌", "┘", "▼"}},
+		{format: graphviz.ASCII, contains: []string{"alpha", "beta", "+---", "|", "v"}},
+		{format: graphviz.SIXEL, contains: []string{"\x1bPq", "\x1b\\"}},
+		{format: graphviz.KITTY, contains: []string{"\x1b_Ga=T,f=100,", "m=0;", "\x1b\\"}},
+	} {
+		t.Run(string(test.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := g.Render(ctx, graph, test.format, &buf); err != nil {
+				t.Fatal(err)
+			}
+			for _, s := range test.contains {
+				if !bytes.Contains(buf.Bytes(), []byte(s)) {
+					t.Fatalf("expected output to contain %q:\n%s", s, buf.String())
+				}
+			}
+			if test.format == graphviz.ASCII {
+				for _, c := range buf.Bytes() {
+					if c >= 0x80 {
+						t.Fatalf("unexpected non-ascii output:\n%s", buf.String())
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRenderPages(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { page="1,1"; a -> b -> c -> d -> e -> f }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	images, err := g.RenderImages(ctx, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) < 2 {
+		t.Fatalf("expected multiple pages but got %d", len(images))
+	}
+	for _, test := range []struct {
+		format graphviz.Format
+		start  string
+	}{
+		{format: graphviz.SIXEL, start: "\x1bPq"},
+		{format: graphviz.KITTY, start: "\x1b_Ga=T,f=100,"},
+	} {
+		t.Run(string(test.format), func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := g.Render(ctx, graph, test.format, &buf); err != nil {
+				t.Fatal(err)
+			}
+			if pages := strings.Count(buf.String(), test.start); pages != len(images) {
+				t.Fatalf("expected %d pages but got %d", len(images), pages)
+			}
+		})
+	}
+	for _, format := range []graphviz.Format{graphviz.PNG, graphviz.JPG} {
+		t.Run(string(format), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "graph."+string(format))
+			if err := g.RenderFilename(ctx, graph, format, path); err != nil {
+				t.Fatal(err)
+			}
+			for i := range images {
+				name := graphviz.PageFileName(path, i+1)
+				f, err := os.Open(name)
+				if err != nil {
+					t.Fatal(err)
+				}
+				_, _, err = image.Decode(f)
+				f.Close()
+				if err != nil {
+					t.Fatalf("failed to decode %s: %v", name, err)
+				}
+			}
+		})
+	}
+}
+
+func TestRenderFilename(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { a -> b }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	path := filepath.Join(t.TempDir(), "graph.svg")
+	if err := g.RenderFilename(ctx, graph, graphviz.SVG, path); err != nil {
+		t.Fatal(err)
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(content, []byte("<svg")) {
+		t.Fatalf("unexpected content: %q", content)
+	}
+}
+
+func TestRenderLayers(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { layers="front:back"; a [layer=front]; b [layer=back]; c [layer=all]; a -> c; b -> c }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	images, err := g.RenderLayers(ctx, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 || images["front"] == nil || images["back"] == nil {
+		t.Fatalf("expected images of the front and back layers but got %v", images)
+	}
+	for _, test := range []struct {
+		layer    string
+		expected string
+		excluded string
+	}{
+		{layer: "front", expected: ">a<", excluded: ">b<"},
+		{layer: "2", expected: ">b<", excluded: ">a<"},
+	} {
+		var buf bytes.Buffer
+		if err := g.RenderLayer(ctx, graph, graphviz.SVG, test.layer, &buf); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Contains(buf.Bytes(), []byte(test.expected)) || bytes.Contains(buf.Bytes(), []byte(test.excluded)) {
+			t.Fatalf("unexpected output for layer %s: %s", test.layer, buf.String())
+		}
+		if !bytes.Contains(buf.Bytes(), []byte(">c<")) {
+			t.Fatalf("expected node in all layers to be rendered: %s", buf.String())
+		}
+	}
+	for sym, err := range graph.Attributes(cgraph.GRAPH) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sym.Name() == "layerselect" {
+			t.Fatal("expected the graph not to declare layerselect")
+		}
+	}
+	var buf bytes.Buffer
+	if err := g.RenderLayer(ctx, graph, graphviz.PNG, "back", &buf); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := image.Decode(&buf); err != nil {
+		t.Fatal(err)
+	}
+
+	selected, err := graphviz.ParseBytes([]byte(`digraph G { layers="front back"; layerselect=front; a [layer=front]; b [layer=back] }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer selected.Close()
+	if images, err = g.RenderLayers(ctx, selected); err != nil {
+		t.Fatal(err)
+	}
+	if len(images) != 2 || selected.GetStr("layerselect") != "front" {
+		t.Fatalf("expected every layer without changing layerselect but got %d images and %q", len(images), selected.GetStr("layerselect"))
+	}
+}
+
+func TestGraphIterators(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`
+digraph G {
+  node [shape=box];
+  subgraph cluster_0 {
+    a; b;
+    subgraph cluster_1 { c }
+  }
+  subgraph cluster_2 { d }
+  a -> b; a -> c; b -> c; c -> d; d -> a;
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	var nodes []string
+	for n, err := range graph.Nodes() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, err := n.Name()
+		if err != nil {
+			t.Fatal(err)
+		}
+		nodes = append(nodes, name)
+	}
+	if len(nodes) != 4 {
+		t.Fatalf("unexpected nodes %v", nodes)
+	}
+	var edges int
+	for _, err := range graph.Edges() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		edges++
+	}
+	if edges != 5 {
+		t.Fatalf("expected 5 edges but got %d", edges)
+	}
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := graph.NodeByName("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out, in int
+	for _, err := range graph.OutEdges(a) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		out++
+	}
+	for _, err := range graph.InEdges(c) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		in++
+	}
+	if out != 2 || in != 2 {
+		t.Fatalf("unexpected degree: out %d in %d", out, in)
+	}
+	var subgraphs []string
+	for sub, err := range graph.SubGraphs() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, err := sub.Name()
+		if err != nil {
+			t.Fatal(err)
+		}
+		subgraphs = append(subgraphs, name)
+	}
+	if len(subgraphs) != 3 || subgraphs[0] != "cluster_0" || subgraphs[1] != "cluster_1" || subgraphs[2] != "cluster_2" {
+		t.Fatalf("unexpected subgraphs %v", subgraphs)
+	}
+	for range graph.SubGraphs() {
+		// stopping early must not panic.
+		break
+	}
+	var attrs []string
+	for sym, err := range graph.Attributes(cgraph.NODE) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		attrs = append(attrs, sym.Name())
+	}
+	var found bool
+	for _, attr := range attrs {
+		if attr == "shape" {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected shape attribute in %v", attrs)
+	}
+}
+
+func TestAttributeGetters(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`
+digraph G {
+  rankdir=LR; size="7.5,10!";
+  node [shape=box];
+  a [color="red;0.3:blue"];
+  a -> b [arrowhead=odot, style="dashed,bold"];
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	if v, ok := graph.RankDir(); !ok || v != cgraph.LRRank {
+		t.Fatalf("unexpected rankdir %q %v", v, ok)
+	}
+	if v, ok := graph.Size(); !ok || v != (cgraph.Point{X: 7.5, Y: 10, Fixed: true}) {
+		t.Fatalf("unexpected size %+v %v", v, ok)
+	}
+	if v, ok := graph.NodeSeparator(); ok || v != 0.25 {
+		t.Fatalf("expected default nodesep but got %v %v", v, ok)
+	}
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := a.Shape(); !ok || v != cgraph.BoxShape {
+		t.Fatalf("unexpected shape %q %v", v, ok)
+	}
+	if v, ok := a.PenWidth(); ok || v != 1 {
+		t.Fatalf("expected default penwidth but got %v %v", v, ok)
+	}
+	color, ok := a.Color()
+	if !ok || len(color) != 2 || color[0].Color.Name != "red" || color[0].Weight != 0.3 || color[1].Color.Name != "blue" {
+		t.Fatalf("unexpected color %v %v", color, ok)
+	}
+	if color.String() != "red;0.3:blue" {
+		t.Fatalf("unexpected color string %q", color.String())
+	}
+	e, err := graph.FirstOut(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := e.ArrowHead(); !ok || v != cgraph.ODotArrow {
+		t.Fatalf("unexpected arrowhead %q %v", v, ok)
+	}
+	style, ok := e.Style()
+	if !ok || len(style) != 2 || style[0] != cgraph.DashedEdgeStyle || style[1] != cgraph.BoldEdgeStyle {
+		t.Fatalf("unexpected style %v %v", style, ok)
+	}
+	if v, ok := e.ArrowTail(); ok || v != cgraph.NormalArrow {
+		t.Fatalf("expected default arrowtail but got %q %v", v, ok)
+	}
+}
+
+func TestTrySetAttributes(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { a -> b; subgraph cluster_0 { c } }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := graph.FirstOut(a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cluster, err := graph.SubGraphByName("cluster_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		name  string
+		set   func() error
+		valid bool
+	}{
+		{"color name", func() error { return a.TrySetColor("Dark Orange") }, true},
+		{"numbered x11 color", func() error { return a.TrySetColor("red3") }, true},
+		{"rgba color", func() error { return a.TrySetColor("#ff000080") }, true},
+		{"hsv color", func() error { return a.TrySetFontColor("0.5 0.3,1") }, true},
+		{"brewer color", func() error { return a.TrySetFillColor("/blues9/3") }, true},
+		{"weighted color list", func() error { return e.TrySetColor("red;0.3:blue") }, true},
+		{"unknown color", func() error { return a.TrySetColor("not-a-color") }, false},
+		{"brewer index out of range", func() error { return a.TrySetColor("/blues3/4") }, false},
+		{"too heavy color list", func() error { return e.TrySetColor("red;0.7:blue;0.5") }, false},
+		{"unknown color scheme", func() error { return a.TrySetColorScheme("blues42") }, false},
+		{"arrow grammar", func() error { return e.TrySetArrowHead("olboxdot") }, true},
+		{"arrow synonym", func() error { return e.TrySetArrowTail(cgraph.HalfOpenArrow) }, true},
+		{"unknown arrow", func() error { return e.TrySetArrowHead("arrow") }, false},
+		{"too many arrows", func() error { return e.TrySetArrowHead("dotdotdotdotdot") }, false},
+		{"style list", func() error { return a.TrySetStyle("filled,rounded") }, true},
+		{"edge style on node", func() error { return a.TrySetStyle("tapered") }, false},
+		{"cluster style", func() error { return cluster.TrySetStyle("filled") }, true},
+		{"escString", func() error { return a.TrySetLabel(`\N\l`) }, true},
+		{"html label", func() error { return a.TrySetLabel("<<b>a</b>>") }, true},
+		{"unknown escape", func() error { return a.TrySetLabel(`\x`) }, true},
+		{"trailing backslash", func() error { return a.TrySetLabel(`a\`) }, false},
+		{"rect", func() error { return graph.TrySetBB(0, 0, 10, 20) }, true},
+		{"pack mode", func() error { return graph.TrySetPackMode("array_c4") }, true},
+		{"unknown pack mode", func() error { return graph.TrySetPackMode("array_z") }, false},
+		{"unknown rankdir", func() error { return graph.TrySetRankDir("XY") }, false},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.set()
+			if test.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			var attrErr *cgraph.AttributeError
+			if !errors.As(err, &attrErr) {
+				t.Fatalf("expected AttributeError but got %v", err)
+			}
+		})
+	}
+	if err := a.TrySetColor("red3"); err != nil {
+		t.Fatal(err)
+	}
+	err = a.TrySetColor("nosuchcolor")
+	if !errors.Is(err, cgraph.ErrUnknownColor) {
+		t.Fatalf("expected ErrUnknownColor but got %v", err)
+	}
+	if v := a.GetStr("color"); v != "red3" {
+		t.Fatalf("invalid value must not be set: color is %q", v)
+	}
+}
+
+func TestAttributeInfo(t *testing.T) {
+	specs := cgraph.AttributeInfo("rankdir")
+	if len(specs) != 1 {
+		t.Fatalf("unexpected specs %v", specs)
+	}
+	rankdir := specs[0]
+	if rankdir.Type != "rankdir" || rankdir.Default != "TB" || !rankdir.AppliesTo(cgraph.GraphKind) || rankdir.AppliesTo(cgraph.NodeKind) {
+		t.Fatalf("unexpected spec %+v", rankdir)
+	}
+	if !rankdir.UsedBy("dot") || rankdir.UsedBy("neato") {
+		t.Fatalf("unexpected engines %v", rankdir.Engines)
+	}
+	if specs := cgraph.AttributeInfo("label"); len(specs) != 3 {
+		t.Fatalf("expected a spec for each kind but got %d", len(specs))
+	}
+	fontsize := cgraph.AttributeInfo("fontsize")[0]
+	if fontsize.Minimum == nil || *fontsize.Minimum != 1 {
+		t.Fatalf("unexpected minimum %v", fontsize.Minimum)
+	}
+	if cgraph.AttributeInfo("nosuchattr") != nil {
+		t.Fatal("expected nil for unknown attribute")
+	}
+	names := map[string]bool{}
+	for _, spec := range cgraph.AttributeSpecs() {
+		names[spec.Name] = true
+	}
+	for _, name := range []string{"class", "xlabel", "TBbalance", "oneblock", "beautify", "linelength", "cluster"} {
+		if !names[name] {
+			t.Errorf("%s is not in the schema", name)
+		}
+	}
+
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { rankdir=LR; oneblock=true; a [rankdir=TB, foo=bar]; a -> b [weight=2] }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	node, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := node.TrySetFontSize(0.5); !errors.Is(err, cgraph.ErrOutOfRange) {
+		t.Fatalf("expected ErrOutOfRange but got %v", err)
+	}
+	node.SetClass("important")
+	if v, ok := node.Class(); !ok || v != "important" {
+		t.Fatalf("unexpected class %q", v)
+	}
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	warnings, err := g.IgnoredAttributes(graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := map[string]string{}
+	for _, w := range warnings {
+		got[w.Name] = w.String()
+	}
+	if len(got) != 3 || got["oneblock"] == "" || got["rankdir"] == "" || got["foo"] == "" {
+		t.Fatalf("unexpected warnings for dot %v", got)
+	}
+	warnings, err = g.SetLayout(graphviz.NEATO).IgnoredAttributes(graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got = map[string]string{}
+	for _, w := range warnings {
+		got[w.Name] = w.String()
+	}
+	if got["oneblock"] == "" || got["weight"] != "" {
+		t.Fatalf("unexpected warnings for neato %v", got)
+	}
+}
+
+func TestColor(t *testing.T) {
+	for _, test := range []struct {
+		value    string
+		expected color.NRGBA
+		str      string
+	}{
+		{"red", color.NRGBA{R: 0xff, A: 0xff}, "red"},
+		{"Dark Orange", color.NRGBA{R: 0xff, G: 0x8c, A: 0xff}, "Dark Orange"},
+		{"green", color.NRGBA{G: 0xff, A: 0xff}, "green"},
+		{"/svg/green", color.NRGBA{G: 0x80, A: 0xff}, "/svg/green"},
+		{"red3", color.NRGBA{R: 0xcd, A: 0xff}, "red3"},
+		{"gray50", color.NRGBA{R: 0x7f, G: 0x7f, B: 0x7f, A: 0xff}, "gray50"},
+		{"#ff000080", color.NRGBA{R: 0xff, A: 0x80}, "#ff000080"},
+		{"#00FF00", color.NRGBA{G: 0xff, A: 0xff}, "#00ff00"},
+		{"0.0 1.0 1.0", color.NRGBA{R: 0xff, A: 0xff}, "#ff0000"},
+		{"0.6666667,1,1", color.NRGBA{B: 0xff, A: 0xff}, "#0000ff"},
+		{"/blues9/3", color.NRGBA{R: 0xc6, G: 0xdb, B: 0xef, A: 0xff}, "/blues9/3"},
+		{"/rdbu3/1", color.NRGBA{R: 0xef, G: 0x8a, B: 0x62, A: 0xff}, "/rdbu3/1"},
+		{"/set312/12", color.NRGBA{R: 0xff, G: 0xed, B: 0x6f, A: 0xff}, "/set312/12"},
+	} {
+		t.Run(test.value, func(t *testing.T) {
+			c, err := cgraph.ParseColor(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.NRGBA != test.expected {
+				t.Fatalf("expected %v but got %v", test.expected, c.NRGBA)
+			}
+			if c.String() != test.str {
+				t.Fatalf("expected %q but got %q", test.str, c.String())
+			}
+		})
+	}
+	for _, v := range []string{"nosuchcolor", "#ff00", "/blues9/10", "/nosuchscheme/1", "1.5,0,0"} {
+		if _, err := cgraph.ParseColor(v); err == nil {
+			t.Errorf("expected error for %q", v)
+		}
+	}
+	half := cgraph.RGBA(0xff, 0, 0, 0x80)
+	if v := half.ToRGBA(); v != (color.RGBA{R: 0x80, A: 0x80}) {
+		t.Fatalf("unexpected premultiplied color %v", v)
+	}
+	if h, s, v := cgraph.HSV(0.5, 1, 1).HSV(); h != 0.5 || s != 1 || v != 1 {
+		t.Fatalf("unexpected hsv %v %v %v", h, s, v)
+	}
+	list, err := cgraph.ParseColorList("red;0.3:/accent3/2")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 2 || list[1].Color.NRGBA != (color.NRGBA{R: 0xbe, G: 0xae, B: 0xd4, A: 0xff}) {
+		t.Fatalf("unexpected color list %v", list)
+	}
+
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { node [colorscheme=oranges9]; a [fillcolor="2:4", fontcolor=9] }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fill, ok := a.FillColor()
+	if !ok || len(fill) != 2 || fill[0].Color.NRGBA != (color.NRGBA{R: 0xfe, G: 0xe6, B: 0xce, A: 0xff}) {
+		t.Fatalf("unexpected fillcolor %v %v", fill, ok)
+	}
+	font, ok := a.FontColor()
+	if !ok || font.NRGBA != (color.NRGBA{R: 0x7f, G: 0x27, B: 0x04, A: 0xff}) {
+		t.Fatalf("unexpected fontcolor %v %v", font, ok)
+	}
+	a.SetFillColor(cgraph.ColorList{{Color: cgraph.RGB(0, 0, 0xff), Weight: 0.5}, {Color: font}}.String())
+	if v := a.GetStr("fillcolor"); v != "#0000ff;0.5:9" {
+		t.Fatalf("unexpected fillcolor %q", v)
+	}
+}
+
+func TestHTMLLabel(t *testing.T) {
+	table := htmllabel.NewTable(
+		htmllabel.NewRow(
+			htmllabel.NewCell(htmllabel.Text("a & b")).Port("f0"),
+			htmllabel.NewCell(htmllabel.NewFormat(htmllabel.Bold, htmllabel.Text("c"))).ColSpan(2).WithVR(),
+		),
+		htmllabel.NewRow(
+			htmllabel.NewCell(htmllabel.NewFont(htmllabel.Text("d"), htmllabel.NewBreak().Align("LEFT")).Color("red").PointSize(10)),
+		).WithHR(),
+	).Border(0).CellBorder(1)
+	expected := `<TABLE BORDER="0" CELLBORDER="1"><TR><TD PORT="f0">a &amp; b</TD><VR/><TD COLSPAN="2"><B>c</B></TD></TR>` +
+		`<HR/><TR><TD><FONT COLOR="red" POINT-SIZE="10">d<BR ALIGN="LEFT"/></FONT></TD></TR></TABLE>`
+	if table.String() != expected {
+		t.Fatalf("expected %s but got %s", expected, table.String())
+	}
+	if err := table.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := htmllabel.ParseTable(expected)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if parsed.String() != expected {
+		t.Fatalf("expected %s but got %s", expected, parsed.String())
+	}
+
+	for _, test := range []struct {
+		name  string
+		label htmllabel.Label
+		err   error
+	}{
+		{"unknown attribute", htmllabel.Label{htmllabel.NewTable(htmllabel.NewRow(htmllabel.NewCell().Set("FACE", "x")))}, htmllabel.ErrUnknownAttribute},
+		{"invalid value", htmllabel.Label{htmllabel.NewTable(htmllabel.NewRow(htmllabel.NewCell())).Border(200)}, htmllabel.ErrInvalidValue},
+		{"empty table", htmllabel.Label{htmllabel.NewTable()}, htmllabel.ErrInvalidContent},
+		{"table with text", htmllabel.Label{htmllabel.Text("a"), htmllabel.NewTable(htmllabel.NewRow(htmllabel.NewCell()))}, htmllabel.ErrInvalidContent},
+		{"table in subscript", htmllabel.Label{htmllabel.NewFormat(htmllabel.Subscript, htmllabel.NewTable(htmllabel.NewRow(htmllabel.NewCell())))}, htmllabel.ErrInvalidContent},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			if err := test.label.Validate(); !errors.Is(err, test.err) {
+				t.Fatalf("expected %v but got %v", test.err, err)
+			}
+		})
+	}
+	for _, v := range []string{"<TABLE><TR>a</TR></TABLE>", "<TABLE><TR><TD>a</TD></TR>", "<BLINK>a</BLINK>"} {
+		if _, err := htmllabel.Parse(v); err == nil {
+			t.Errorf("expected error for %q", v)
+		}
+	}
+
+	graph, err := graphviz.ParseFile("testdata/directed/table.gv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	for node, err := range graph.Nodes() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		label := node.GetStr("label")
+		l, err := htmllabel.Parse(label)
+		if err != nil {
+			t.Fatalf("failed to parse %s: %v", label, err)
+		}
+		again, err := htmllabel.Parse(l.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if again.String() != l.String() {
+			t.Fatalf("expected %s but got %s", l.String(), again.String())
+		}
+	}
+
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	newGraph, err := g.Graph()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer newGraph.Close()
+	n, err := newGraph.CreateNodeByName("n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.SetShape(cgraph.PlainTextShape)
+	if err := n.TrySetHTMLLabel(table); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := g.Render(ctx, newGraph, graphviz.XDOT, &buf); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(buf.Bytes(), []byte("label=<"+expected+">")) {
+		t.Fatalf("failed to set html label: %s", buf.String())
+	}
+	var attrErr *cgraph.AttributeError
+	if err := n.TrySetHTMLLabel(htmllabel.NewTable()); !errors.As(err, &attrErr) {
+		t.Fatalf("expected AttributeError but got %v", err)
+	}
+
+	html, err := newGraph.CreateNodeByName("html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := html.SafeSetHTML("label", "<b>html</b>", "\\N"); err != nil {
+		t.Fatal(err)
+	}
+	if !html.IsHTML("label") {
+		t.Fatal("expected an HTML label")
+	}
+	// the HTML string is freed when it is no longer used, so the same string can be set as a normal string.
+	if err := html.SafeSet("label", "html", "\\N"); err != nil {
+		t.Fatal(err)
+	}
+	plain, err := newGraph.CreateNodeByName("plain")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := plain.SafeSet("label", "<b>html</b>", "\\N"); err != nil {
+		t.Fatal(err)
+	}
+	if plain.IsHTML("label") {
+		t.Fatal("expected a normal label")
+	}
+	if err := html.SafeSetHTML("label", "<b>html</b>", "\\N"); !errors.Is(err, cgraph.ErrHTMLConflict) {
+		t.Fatalf("expected ErrHTMLConflict but got %v", err)
+	}
+	if html.GetStr("label") != "html" {
+		t.Fatalf("expected the label not to be changed but got %q", html.GetStr("label"))
+	}
+}
+
+func TestRecordLabel(t *testing.T) {
+	for _, test := range []struct {
+		label    string
+		expected string
+		ports    []string
+	}{
+		{"<f0> left|<f1> middle|<f2> right", "<f0> left|<f1> middle|<f2> right", []string{"f0", "f1", "f2"}},
+		{`hello\nworld |{ b |{c|<here> d|e}| f}| g | h`, `hello\nworld|{b|{c|<here> d|e}|f}|g|h`, []string{"here"}},
+		{`{<f0> a\|b | <f1>}`, `{<f0> a\|b|<f1>}`, []string{"f0", "f1"}},
+		{"", "", nil},
+	} {
+		t.Run(test.label, func(t *testing.T) {
+			l, err := recordlabel.Parse(test.label)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if l.String() != test.expected {
+				t.Fatalf("expected %q but got %q", test.expected, l.String())
+			}
+			ports := l.Ports()
+			if len(ports) != len(test.ports) {
+				t.Fatalf("expected ports %v but got %v", test.ports, ports)
+			}
+			for i := range ports {
+				if ports[i] != test.ports[i] {
+					t.Fatalf("expected ports %v but got %v", test.ports, ports)
+				}
+			}
+		})
+	}
+	for _, v := range []string{"{a", "a}", "<f0 a", "a <f0>", "{a} b", "a > b"} {
+		if _, err := recordlabel.Parse(v); !errors.Is(err, recordlabel.ErrSyntax) {
+			t.Errorf("expected syntax error for %q but got %v", v, err)
+		}
+	}
+
+	l, err := recordlabel.Parse(`a|{<p> b|c}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l[1].IsGroup() || l[1].Fields[0].Text != "b" || l.FieldByPort("p") != l[1].Fields[0] {
+		t.Fatalf("unexpected fields %v", l)
+	}
+	if err := (recordlabel.Label{recordlabel.NewField("a").WithPort("p"), recordlabel.NewField("b").WithPort("p")}).Validate(); !errors.Is(err, recordlabel.ErrDuplicatePort) {
+		t.Fatalf("expected duplicate port error but got %v", err)
+	}
+
+	graph, err := graphviz.ParseFile("testdata/directed/structs.gv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	n, err := graph.NodeByName("struct3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := n.RecordLabel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsed.HasPort("here") {
+		t.Fatalf("failed to find port: %v", parsed.Ports())
+	}
+	n.SetRecordLabel(recordlabel.Label{
+		recordlabel.NewField("x").WithPort("in"),
+		recordlabel.NewGroup(recordlabel.NewField("y{1}"), recordlabel.NewField("z").WithPort("out")),
+	})
+	if v := n.GetStr("label"); v != `<in> x|{y\{1\}|<out> z}` {
+		t.Fatalf("unexpected label %q", v)
+	}
+}
+
+func TestEdgePorts(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { a [shape=record, label="<f0> x|<f1> y"]; a:f0:n -> b:s; a:f1 -> b:_ }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := graph.NodeByName("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ports [][2]cgraph.Port
+	for e, err := range graph.Edges() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		tail, head, err := e.Ports()
+		if err != nil {
+			t.Fatal(err)
+		}
+		ports = append(ports, [2]cgraph.Port{tail, head})
+	}
+	expected := [][2]cgraph.Port{
+		{{Node: a, Name: "f0", Compass: cgraph.NorthCompass}, {Node: b, Compass: cgraph.SouthCompass}},
+		{{Node: a, Name: "f1"}, {Node: b, Compass: cgraph.AnyCompass}},
+	}
+	if len(ports) != len(expected) {
+		t.Fatalf("expected %v but got %v", expected, ports)
+	}
+	for i := range ports {
+		for j := range ports[i] {
+			got, exp := ports[i][j], expected[i][j]
+			gotNode, _ := got.Node.Name()
+			expNode, _ := exp.Node.Name()
+			if got.Name != exp.Name || got.Compass != exp.Compass || gotNode != expNode {
+				t.Fatalf("expected %v but got %v", exp, got)
+			}
+		}
+	}
+
+	n, err := graph.CreateNodeByName("n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	n.SetShape(cgraph.PlainTextShape).SetHTMLLabel(htmllabel.NewTable(htmllabel.NewRow(htmllabel.NewCell(htmllabel.Text("n")).Port("in"))))
+	if _, err := graph.CreateEdgeBetweenPorts("", cgraph.Port{Node: a, Name: "f1", Compass: cgraph.EastCompass}, cgraph.Port{Node: n, Name: "in", Compass: cgraph.WestCompass}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := graph.CreateEdgeBetweenPorts("", cgraph.Port{Node: a, Name: "f2"}, cgraph.Port{Node: n}); !errors.Is(err, cgraph.ErrUnknownPort) {
+		t.Fatalf("expected unknown port error but got %v", err)
+	}
+	if _, err := graph.CreateEdgeBetweenPorts("", cgraph.Port{Node: a}, cgraph.Port{Node: n, Compass: "up"}); !errors.Is(err, cgraph.ErrUnknownCompassPoint) {
+		t.Fatalf("expected unknown compass point error but got %v", err)
+	}
+	// a record label is not read as an HTML-like label even if it looks like one.
+	r, err := graph.CreateNodeByName("r")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.SetShape(cgraph.RecordShape).SetLabel("<br/> x")
+	recordPorts, err := r.Ports()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(recordPorts, []string{"br/"}) {
+		t.Fatalf("expected the record port br/ but got %v", recordPorts)
+	}
+	var buf bytes.Buffer
+	if err := g.Render(ctx, graph, graphviz.XDOT, &buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, edge := range []string{"a:f0:n -> b:s", "a:f1 -> b:_", "a:f1:e -> n:in:w"} {
+		if !bytes.Contains(buf.Bytes(), []byte(edge)) {
+			t.Fatalf("failed to find %q in %s", edge, buf.String())
+		}
+	}
+}
+
+func TestCloneAndMerge(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	render := func(graph *cgraph.Graph) string {
+		t.Helper()
+		var buf bytes.Buffer
+		if err := g.Render(ctx, graph, "canon", &buf); err != nil {
+			t.Fatal(err)
+		}
+		return buf.String()
+	}
+	graph, err := graphviz.ParseBytes([]byte(`digraph G {
+  label="root"; node [shape=box];
+  subgraph cluster_0 { label="c0"; node [color=red, xlabel=<<i>x</i>>]; a -> b [color=blue]; }
+  b -> c; b -> c; c -> d [key=k]; d [label=<<b>d</b>>];
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+
+	clone, err := graph.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer clone.Close()
+	if expected, got := render(graph), render(clone); expected != got {
+		t.Fatalf("expected %s but got %s", expected, got)
+	}
+
+	parallel, err := graphviz.ParseBytes([]byte(`digraph G { a -> b; a -> b [color=red]; a -> b [key=k] }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer parallel.Close()
+	parallelClone, err := parallel.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer parallelClone.Close()
+	var dot bytes.Buffer
+	if err := g.Render(ctx, parallelClone, graphviz.XDOT, &dot); err != nil {
+		t.Fatal(err)
+	}
+	// parallel edges without keys are copied without keys.
+	if strings.Count(dot.String(), "key=") != 1 {
+		t.Fatalf("expected only the key k: %s", dot.String())
+	}
+	var keys []string
+	for e, err := range parallelClone.Edges() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		key, err := e.Name()
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, key)
+	}
+	if strings.Join(keys, ",") != ",,k" {
+		t.Fatalf("unexpected keys %q", keys)
+	}
+
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := graph.NodeByName("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	extracted, err := graph.ExtractSubgraph([]*cgraph.Node{a, b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer extracted.Close()
+	nodes, err := extracted.NodeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	edges, err := extracted.EdgeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodes != 2 || edges != 1 {
+		t.Fatalf("unexpected extracted graph %s", render(extracted))
+	}
+	cluster, err := extracted.SubGraphByName("cluster_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster == nil || cluster.GetStr("label") != "c0" {
+		t.Fatalf("failed to extract cluster: %s", render(extracted))
+	}
+	if n, _ := extracted.NodeByName("a"); n.GetStr("color") != "red" || n.GetStr("shape") != "box" {
+		t.Fatalf("failed to copy attribute defaults: %s", render(extracted))
+	}
+
+	other, err := graphviz.ParseBytes([]byte(`digraph H { a [color=green]; b -> c; b -> c; b -> c; e; subgraph cluster_0 { e } }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer other.Close()
+	if err := clone.Merge(other, cgraph.MergeError); !errors.Is(err, cgraph.ErrMergeConflict) {
+		t.Fatalf("expected merge conflict but got %v", err)
+	}
+	merged, err := graph.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer merged.Close()
+	if err := merged.Merge(other, cgraph.MergeKeep); err != nil {
+		t.Fatal(err)
+	}
+	edges, err = merged.EdgeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edges != 5 {
+		t.Fatalf("unexpected merged graph %s", render(merged))
+	}
+	if n, _ := merged.NodeByName("a"); n.GetStr("color") != "red" {
+		t.Fatalf("unexpected color %q", n.GetStr("color"))
+	}
+	if err := merged.Merge(other, cgraph.MergeOverwrite); err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := merged.NodeByName("a"); n.GetStr("color") != "green" {
+		t.Fatalf("unexpected color %q", n.GetStr("color"))
+	}
+	cluster, err = merged.SubGraphByName("cluster_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ := merged.NodeByName("e"); n == nil {
+		t.Fatal("failed to merge node")
+	} else if sub, _ := cluster.SubNode(n); sub == nil {
+		t.Fatal("failed to merge subgraph")
+	}
+}
+
+// edgeBetween returns the first edge from tail to head. Edges without keys cannot be found by EdgeByName.
+func edgeBetween(t *testing.T, graph *cgraph.Graph, tail, head *cgraph.Node) *cgraph.Edge {
+	t.Helper()
+	headName, err := head.Name()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for e, err := range graph.OutEdges(tail) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		h, err := e.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name, _ := h.Name(); name == headName {
+			return e
+		}
+	}
+	return nil
+}
+
+func TestDiff(t *testing.T) {
+	a, err := graphviz.ParseBytes([]byte(`digraph G {
+  subgraph cluster_0 { label=old; x; y }
+  subgraph cluster_1 { z }
+  x -> y; y -> z [color=red]; y -> z; z -> w;
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	b, err := graphviz.ParseBytes([]byte(`digraph G {
+  label=new;
+  subgraph cluster_0 { label=new; x; y; v }
+  subgraph cluster_2 { v }
+  x -> y; y -> z [color=blue]; x -> v;
+  w [shape=box];
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer b.Close()
+	d, err := cgraph.Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `~ graph: label "" -> "new"
++ subgraph cluster_2
+- subgraph cluster_1
+~ subgraph cluster_0: label "old" -> "new"
++ subgraph cluster_0: node v
++ node v
+~ node w: shape "" -> "box"
++ edge x -> v
+- edge y -> z #2
+- edge z -> w
+~ edge y -> z: color "red" -> "blue"
+`
+	if d.String() != expected {
+		t.Fatalf("expected %s but got %s", expected, d.String())
+	}
+	if d, err := cgraph.Diff(a, a); err != nil || !d.Empty() {
+		t.Fatalf("expected no differences but got %v %v", d, err)
+	}
+
+	combined, err := cgraph.DiffGraph(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer combined.Close()
+	for _, test := range []struct {
+		name  string
+		color string
+		style string
+	}{
+		{"v", "green", ""},
+		{"x", "", ""},
+	} {
+		n, err := combined.NodeByName(test.name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n.GetStr("color") != test.color || n.GetStr("style") != test.style {
+			t.Fatalf("unexpected attributes of %s: color=%q style=%q", test.name, n.GetStr("color"), n.GetStr("style"))
+		}
+	}
+	z, err := combined.NodeByName("z")
+	if err != nil {
+		t.Fatal(err)
+	}
+	w, err := combined.NodeByName("w")
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := edgeBetween(t, combined, z, w)
+	if e == nil || e.GetStr("color") != "red" || e.GetStr("style") != "dashed" {
+		t.Fatal("failed to mark removed edge")
+	}
+	cluster, err := combined.SubGraphByName("cluster_1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster == nil || cluster.GetStr("color") != "red" || cluster.GetStr("style") != "dashed" {
+		t.Fatal("failed to mark removed subgraph")
+	}
+}
+
+func TestTransitiveReduction(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { a -> b [color=red]; b -> c; a -> c; c -> d; a -> d; d -> e; e -> d; }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	if err := cgraph.TransitiveReduction(graph); err != nil {
+		t.Fatal(err)
+	}
+	edges, err := graph.EdgeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edges != 5 {
+		t.Fatalf("expected 5 edges but got %d", edges)
+	}
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for e, err := range graph.OutEdges(a) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		head, err := e.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if name, _ := head.Name(); name != "b" || e.GetStr("color") != "red" {
+			t.Fatalf("unexpected edge to %s", name)
+		}
+	}
+
+	undirected, err := graphviz.ParseBytes([]byte(`graph G { a -- b; b -- c; a -- c }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer undirected.Close()
+	if err := cgraph.TransitiveReduction(undirected); err != nil {
+		t.Fatal(err)
+	}
+	if edges, _ := undirected.EdgeNum(); edges != 3 {
+		t.Fatalf("expected undirected graph not to be changed but got %d edges", edges)
+	}
+}
+
+func TestComponents(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { node [shape=box]; a -> b; b -> a; b -> c; c -> d; d -> c; e -> f; g }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+
+	t.Run("connected", func(t *testing.T) {
+		components, err := cgraph.ConnectedComponents(graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer func() {
+			for _, c := range components {
+				c.Close()
+			}
+		}()
+		if len(components) != 3 {
+			t.Fatalf("expected 3 components but got %d", len(components))
+		}
+		for i, expected := range []int{4, 2, 1} {
+			nodes, err := components[i].NodeNum()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if nodes != expected {
+				t.Fatalf("expected %d nodes in component %d but got %d", expected, i, nodes)
+			}
+		}
+		e, err := components[1].NodeByName("e")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e == nil || e.GetStr("shape") != "box" {
+			t.Fatal("expected node e with the default shape")
+		}
+	})
+	t.Run("strongly connected", func(t *testing.T) {
+		m, err := cgraph.StronglyConnectedComponents(graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer m.Close()
+		if got := fmt.Sprint(m.Components); got != "[[g] [e] [f] [a b] [c d]]" {
+			t.Fatalf("unexpected components %s", got)
+		}
+		cluster, err := m.Graph.SubGraphByName("cluster_3")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if cluster == nil {
+			t.Fatal("expected cluster_3 subgraph")
+		}
+		edges, err := cluster.EdgeNum()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if edges != 2 {
+			t.Fatalf("expected 2 edges in cluster_3 but got %d", edges)
+		}
+		g, err := graphviz.New(context.Background())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer g.Close()
+		var buf bytes.Buffer
+		if err := g.Render(context.Background(), m.Map, "canon", &buf); err != nil {
+			t.Fatal(err)
+		}
+		for _, expected := range []string{"cluster_3 -> cluster_4", "e -> f"} {
+			if !bytes.Contains(buf.Bytes(), []byte(expected)) {
+				t.Fatalf("expected %q in the map:\n%s", expected, buf.String())
+			}
+		}
+
+		undirected, err := graphviz.ParseBytes([]byte(`graph G { a -- b }`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer undirected.Close()
+		if _, err := cgraph.StronglyConnectedComponents(undirected); err == nil {
+			t.Fatal("expected an error for an undirected graph")
+		}
+	})
+}
+
+func TestAcyclicAndUnflatten(t *testing.T) {
+	t.Run("acyclic", func(t *testing.T) {
+		graph, err := graphviz.ParseBytes([]byte(`digraph G { a -> b [color=red]; b -> c; c -> a [tailport=e, color=blue]; c -> c }`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		reversed, err := cgraph.Acyclic(graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if reversed != 1 {
+			t.Fatalf("expected 1 reversed edge but got %d", reversed)
+		}
+		a, err := graph.NodeByName("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		c, err := graph.NodeByName("c")
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := edgeBetween(t, graph, a, c)
+		if e == nil {
+			t.Fatal("expected the reversed edge a -> c")
+		}
+		if e.GetStr("color") != "blue" || e.GetStr("headport") != "e" || e.GetStr("tailport") != "" {
+			t.Fatalf("unexpected attributes of the reversed edge: color=%q headport=%q tailport=%q", e.GetStr("color"), e.GetStr("headport"), e.GetStr("tailport"))
+		}
+		if reversed, err := cgraph.Acyclic(graph); err != nil || reversed != 0 {
+			t.Fatalf("expected an acyclic graph but got %d reversed edges: %v", reversed, err)
+		}
+	})
+	t.Run("unflatten", func(t *testing.T) {
+		graph, err := graphviz.ParseBytes([]byte(`digraph G { r -> l1; r -> l2; r -> l3 [minlen=5]; r -> l4; x; y; z }`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		if err := cgraph.Unflatten(graph, cgraph.UnflattenOptions{MaxMinlen: 2, ChainLimit: 2}); err != nil {
+			t.Fatal(err)
+		}
+		r, err := graph.NodeByName("r")
+		if err != nil {
+			t.Fatal(err)
+		}
+		minlens := map[string]string{}
+		for e, err := range graph.OutEdges(r) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			head, err := e.Head()
+			if err != nil {
+				t.Fatal(err)
+			}
+			name, _ := head.Name()
+			minlens[name] = e.GetStr("minlen")
+		}
+		if got := fmt.Sprint(minlens); got != "map[l1:1 l2:2 l3:5 l4:2]" {
+			t.Fatalf("unexpected minlen %s", got)
+		}
+		edges, err := graph.EdgeNum()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if edges != 6 {
+			t.Fatalf("expected 6 edges with the chain but got %d", edges)
+		}
+		x, err := graph.NodeByName("x")
+		if err != nil {
+			t.Fatal(err)
+		}
+		y, err := graph.NodeByName("y")
+		if err != nil {
+			t.Fatal(err)
+		}
+		e := edgeBetween(t, graph, x, y)
+		if e == nil || e.GetStr("style") != "invis" {
+			t.Fatal("expected an invisible edge x -> y")
+		}
+	})
+}
+
+func TestGVPR(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`digraph G { a -> b [weight=3]; a -> c; b -> c; c -> d; x1; x2 }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	out, err := gvpr.Run(`
+BEGIN { int total = 0; }
+BEG_G { printf("graph %s has %d nodes\n", $G.name, nNodes($G)); }
+N [outdegree > 1] { color = "red"; total++; }
+N [name == "x*"] { delete($G, $); }
+E [$.weight >= 2] { $.style = "bold"; $.tail.shape = "box"; }
+E [$.head.name == "c" && $.tail.name != "a"] { label = sprintf("%s-%s", $.tail.name, $.head.name); }
+END_G { print("edges: ", nEdges($G), ", nodes: ", $G.n_nodes); }
+END { print("total: ", total); }
+`, graph)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "graph G has 6 nodes\nedges: 4, nodes: 4\ntotal: 1\n"; out != expected {
+		t.Fatalf("unexpected output %q", out)
+	}
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.GetStr("color") != "red" || a.GetStr("shape") != "box" {
+		t.Fatalf("unexpected attributes of a: color=%q shape=%q", a.GetStr("color"), a.GetStr("shape"))
+	}
+	b, err := graph.NodeByName("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := graph.NodeByName("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ab := edgeBetween(t, graph, a, b)
+	if ab == nil || ab.GetStr("style") != "bold" {
+		t.Fatal("expected bold a -> b")
+	}
+	bc := edgeBetween(t, graph, b, c)
+	if bc == nil || bc.GetStr("label") != "b-c" {
+		t.Fatal("expected label b-c")
+	}
+	if x1, err := graph.NodeByName("x1"); err != nil || x1 != nil {
+		t.Fatalf("expected x1 to be deleted: %v", err)
+	}
+
+	// x++ and x-- evaluate to the value before the update like C.
+	out, err = gvpr.Run(`BEGIN { int i = 0; printf("%d ", i++); printf("%d ", i); printf("%d ", ++i); printf("%d ", i--); print(i); }`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "0 1 2 2 1\n"; out != expected {
+		t.Fatalf("expected %q but got %q", expected, out)
+	}
+
+	// the ';' can be omitted before '}' and at the end of the input.
+	oneLiner, err := graphviz.ParseBytes([]byte(`digraph { a -> b; c }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer oneLiner.Close()
+	out, err = gvpr.Run(`N[$.degree==0]{delete($G,$)} N{print(name)}`, oneLiner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected := "a\nb\n"; out != expected {
+		t.Fatalf("expected %q but got %q", expected, out)
+	}
+	if _, err := gvpr.Run(`BEGIN { string s = "a" + "b"; }`); !errors.Is(err, gvpr.ErrRuntime) {
+		t.Fatalf("expected a runtime error for strings added but got %v", err)
+	}
+
+	if _, err := gvpr.Run(`N [name == ] {}`); !errors.Is(err, gvpr.ErrSyntax) {
+		t.Fatalf("expected a syntax error but got %v", err)
+	}
+	if _, err := gvpr.Run(`BEGIN { unknown(); }`); !errors.Is(err, gvpr.ErrRuntime) {
+		t.Fatalf("expected a runtime error but got %v", err)
+	}
+}
+
+func TestStats(t *testing.T) {
+	stats := func(t *testing.T, src string) *cgraph.GraphStats {
+		t.Helper()
+		graph, err := graphviz.ParseBytes([]byte(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { graph.Close() })
+		s, err := cgraph.Stats(graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	t.Run("dag", func(t *testing.T) {
+		s := stats(t, `digraph G { a -> b; b -> c; a -> d; subgraph cluster_x { e -> f } subgraph s { g } }`)
+		if s.Nodes != 7 || s.Edges != 4 || s.Components != 3 || s.SubGraphs != 2 || s.Clusters != 1 {
+			t.Fatalf("unexpected counts %+v", s)
+		}
+		if got := fmt.Sprint(s.Degrees); got != "map[0:1 1:4 2:2]" {
+			t.Fatalf("unexpected degrees %s", got)
+		}
+		if got := fmt.Sprint(s.OutDegrees); got != "map[0:4 1:2 2:1]" {
+			t.Fatalf("unexpected out degrees %s", got)
+		}
+		if s.MinDegree != 0 || s.MaxDegree != 2 || s.Diameter != 3 || !s.IsDAG || s.IsTree {
+			t.Fatalf("unexpected metrics %+v", s)
+		}
+		if got := fmt.Sprintf("%.4f", s.Density); got != "0.0952" {
+			t.Fatalf("unexpected density %s", got)
+		}
+		if len(s.Children) != 2 || s.Children[0].Name != "cluster_x" || s.Children[0].Nodes != 2 || s.Children[0].Edges != 1 || !s.Children[0].IsTree {
+			t.Fatalf("unexpected subgraph stats %+v", s.Children)
+		}
+	})
+	t.Run("cycle", func(t *testing.T) {
+		s := stats(t, `digraph G { a -> b; b -> a }`)
+		if s.IsDAG || s.IsTree || s.Components != 1 || s.Density != 1 {
+			t.Fatalf("unexpected metrics %+v", s)
+		}
+	})
+	t.Run("tree", func(t *testing.T) {
+		s := stats(t, `graph G { a -- b; b -- c; b -- d; d -- e }`)
+		if s.IsDAG || !s.IsTree || s.Diameter != 3 || s.AverageDegree != 1.6 {
+			t.Fatalf("unexpected metrics %+v", s)
+		}
+	})
+}
+
+func TestAutoLayout(t *testing.T) {
+	for _, test := range []struct {
+		name     string
+		src      string
+		expected graphviz.Layout
+	}{
+		{"dag", `digraph G { a -> b; b -> c; a -> c }`, graphviz.DOT},
+		{"clusters", `graph G { subgraph cluster_a { a -- b } subgraph cluster_b { c -- d } b -- c }`, graphviz.FDP},
+		{"clusters without edges", `graph G { subgraph cluster_a { a; b } subgraph cluster_b { c } }`, graphviz.OSAGE},
+		{"tree", `graph G { a -- b; a -- c; a -- d; d -- e }`, graphviz.TWOPI},
+		{"cycle", `graph G { a -- b; b -- c; c -- d; d -- a }`, graphviz.CIRCO},
+		{"undirected", `graph G { a -- b; b -- c; c -- a; c -- d }`, graphviz.NEATO},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			graph, err := graphviz.ParseBytes([]byte(test.src))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer graph.Close()
+			s, err := graphviz.SelectLayout(graph)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s.Layout != test.expected {
+				t.Fatalf("expected %s but got %s", test.expected, s)
+			}
+			if len(s.Reasons) == 0 {
+				t.Fatal("expected reasons")
+			}
+		})
+	}
+
+	t.Run("render", func(t *testing.T) {
+		ctx := context.Background()
+		g, err := graphviz.New(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer g.Close()
+		graph, err := g.Graph(graphviz.WithDirectedType(graphviz.UnDirected))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		var prev *cgraph.Node
+		for i := 0; i < 300; i++ {
+			n, err := graph.CreateNodeByName(fmt.Sprintf("n%d", i))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if prev != nil && i%50 != 0 {
+				if _, err := graph.CreateEdgeByName("", prev, n); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if i%3 == 0 && i > 3 {
+				m, err := graph.NodeByName(fmt.Sprintf("n%d", i-3))
+				if err != nil {
+					t.Fatal(err)
+				}
+				if _, err := graph.CreateEdgeByName("", n, m); err != nil {
+					t.Fatal(err)
+				}
+			}
+			prev = n
+		}
+		g.SetLayout(graphviz.Auto)
+		var buf bytes.Buffer
+		if err := g.Render(ctx, graph, graphviz.XDOT, &buf); err != nil {
+			t.Fatal(err)
+		}
+		s := g.LayoutSelection()
+		if s == nil || s.Layout != graphviz.SFDP {
+			t.Fatalf("expected sfdp but got %v", s)
+		}
+		if !strings.Contains(buf.String(), "overlap=prism") {
+			t.Fatalf("expected the layout with overlap=prism but got %s", buf.String())
+		}
+		if _, err := g.IgnoredAttributes(graph); err != nil {
+			t.Fatal(err)
+		}
+		for sym, err := range graph.Attributes(cgraph.GRAPH) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			switch sym.Name() {
+			case "overlap", "pack":
+				t.Fatalf("expected the graph not to be modified but %s is declared", sym.Name())
+			}
+		}
+	})
+}
+
+func TestPack(t *testing.T) {
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	var graphs []*cgraph.Graph
+	for _, src := range []string{
+		`graph A { subgraph cluster_0 { a -- b } b -- c }`,
+		`graph B { subgraph cluster_0 { a -- b [color=red] } }`,
+	} {
+		graph, err := graphviz.ParseBytes([]byte(src))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		graphs = append(graphs, graph)
+	}
+	g.SetLayout(graphviz.NEATO)
+	packed, err := g.Pack(ctx, graphs, graphviz.PackOptions{Mode: graphviz.ArrayPackMode("", 2), Margin: 20})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer packed.Close()
+	if packed.GetStr("packmode") != "array_2" || packed.GetStr("pack") != "20" {
+		t.Fatalf("unexpected pack attributes: packmode=%q pack=%q", packed.GetStr("packmode"), packed.GetStr("pack"))
+	}
+	nodes, err := packed.NodeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nodes != 5 {
+		t.Fatalf("expected 5 nodes but got %d", nodes)
+	}
+	a, err := packed.NodeByName("a_gv1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a == nil || a.GetStr("label") != "a" {
+		t.Fatal("expected a_gv1 labeled a")
+	}
+	cluster, err := packed.SubGraphByName("cluster_0_gv1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster == nil {
+		t.Fatal("expected cluster_0_gv1")
+	}
+
+	var buf bytes.Buffer
+	if err := g.Render(ctx, packed, "plain", &buf); err != nil {
+		t.Fatal(err)
+	}
+	// the components are placed in an array of 2 columns, so the second graph is right to the first one.
+	xs := map[string]float64{}
+	for _, line := range strings.Split(buf.String(), "\n") {
+		var name string
+		var x float64
+		if _, err := fmt.Sscanf(line, "node %s %f", &name, &x); err == nil {
+			xs[name] = x
+		}
+	}
+	if len(xs) != 5 || xs["a_gv1"] <= xs["a"] || xs["b_gv1"] <= xs["c"] {
+		t.Fatalf("unexpected positions %v", xs)
+	}
+
+	if _, err := g.Pack(ctx, graphs, graphviz.PackOptions{Mode: "unknown"}); err == nil {
+		t.Fatal("expected an error for an invalid pack mode")
+	}
+	directed, err := graphviz.ParseBytes([]byte(`digraph C { a -> b }`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer directed.Close()
+	if _, err := g.Pack(ctx, append(graphs, directed), graphviz.PackOptions{}); err == nil {
+		t.Fatal("expected an error for directed and undirected graphs")
+	}
+}
+
+func TestGraphML(t *testing.T) {
+	src := `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns" xmlns:y="http://www.yworks.com/xml/graphml">
+  <key id="d0" for="node" attr.name="color" attr.type="string"><default>blue</default></key>
+  <key id="d1" for="edge" attr.name="weight" attr.type="double"/>
+  <graph id="G" edgedefault="directed">
+    <node id="a"><data key="d0">red</data></node>
+    <node id="cluster_0">
+      <data key="label">group</data>
+      <graph id="cluster_0:" edgedefault="directed">
+        <node id="b"/>
+        <node id="c"><data key="note">unknown key</data><data key="graphics"><y:ShapeNode><y:Fill color="#FF0000"/></y:ShapeNode></data></node>
+      </graph>
+    </node>
+    <node id="d"><data key="label" html="true">&lt;b&gt;bold&lt;/b&gt;</data></node>
+    <edge id="e1" source="a" target="b"><data key="d1">2.5</data></edge>
+    <edge source="b" target="c"/>
+    <edge source="d" target="cluster_0"/>
+  </graph>
+</graphml>`
+	graph, err := cgraph.ParseGraphML(strings.NewReader(src))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	directed, err := graph.IsDirected()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !directed {
+		t.Fatal("expected a directed graph")
+	}
+	a, err := graph.NodeByName("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := graph.NodeByName("b")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := graph.NodeByName("c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.GetStr("color") != "red" || b.GetStr("color") != "blue" || c.GetStr("note") != "unknown key" {
+		t.Fatalf("unexpected node attributes: a=%q b=%q note=%q", a.GetStr("color"), b.GetStr("color"), c.GetStr("note"))
+	}
+	if c.GetStr("graphics") != `<y:ShapeNode><y:Fill color="#FF0000"/></y:ShapeNode>` {
+		t.Fatalf("expected the inner XML of the graphics but got %q", c.GetStr("graphics"))
+	}
+	cluster, err := graph.SubGraphByName("cluster_0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cluster == nil || cluster.GetStr("label") != "group" {
+		t.Fatal("expected cluster_0 labeled group")
+	}
+	clusterNodes, err := cluster.NodeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if clusterNodes != 2 {
+		t.Fatalf("expected 2 nodes in cluster_0 but got %d", clusterNodes)
+	}
+	edges, err := graph.EdgeNum()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if edges != 3 {
+		t.Fatalf("expected 3 edges but got %d", edges)
+	}
+	for e, err := range graph.OutEdges(a) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.GetStr("id") != "e1" || e.GetStr("weight") != "2.5" {
+			t.Fatalf("unexpected edge id=%q weight=%q", e.GetStr("id"), e.GetStr("weight"))
+		}
+	}
+	d, err := graph.NodeByName("d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsHTML("label") || d.GetStr("label") != "<b>bold</b>" {
+		t.Fatalf("expected an HTML label but got %q", d.GetStr("label"))
+	}
+	toCluster := edgeBetween(t, graph, d, b)
+	if toCluster.GetStr("lhead") != "cluster_0" || graph.GetStr("compound") != "true" {
+		t.Fatalf("expected the edge to cluster_0 but got lhead=%q compound=%q", toCluster.GetStr("lhead"), graph.GetStr("compound"))
+	}
+
+	ctx := context.Background()
+	g, err := graphviz.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer g.Close()
+	var svg bytes.Buffer
+	if err := g.Render(ctx, graph, graphviz.SVG, &svg); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(svg.String(), "cluster_0") {
+		t.Fatal("expected cluster_0 in the rendered SVG")
+	}
+	var dot bytes.Buffer
+	if err := g.Render(ctx, graph, "canon", &dot); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(dot.String(), "key=") || !strings.Contains(dot.String(), "label=<<b>bold</b>>") {
+		t.Fatalf("expected the edge ids as attributes and the HTML label but got %s", dot.String())
+	}
+
+	var buf bytes.Buffer
+	if err := graph.WriteGraphML(&buf); err != nil {
+		t.Fatal(err)
+	}
+	parsed, err := cgraph.ParseGraphML(&buf)
+	if err != nil {
+		t.Fatalf("%v\n%s", err, buf.String())
+	}
+	defer parsed.Close()
+	diff, err := cgraph.Diff(graph, parsed)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("unexpected difference after the round trip: %+v\n%s", diff, buf.String())
+	}
+	if strings.Contains(buf.String(), `key="edge_id"`) {
+		t.Fatalf("expected the edge ids only as the id attributes\n%s", buf.String())
+	}
+	parsedD, err := parsed.NodeByName("d")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !parsedD.IsHTML("label") {
+		t.Fatalf("expected the HTML label after the round trip\n%s", buf.String())
+	}
+
+	if _, err := cgraph.ParseGraphML(strings.NewReader(`<graphml><graph><edge source="x" target="y"/></graph></graphml>`)); !errors.Is(err, cgraph.ErrGraphML) {
+		t.Fatalf("expected ErrGraphML for an unknown node but got %v", err)
+	}
+}
+
+// htmlValues returns the attributes of the graph, the subgraphs, the nodes and the edges whose values are HTML strings.
+// cgraph.Diff compares the values as strings, so it does not report the differences between HTML and normal strings.
+func htmlValues(t *testing.T, graph *cgraph.Graph) []string {
+	t.Helper()
+	var values []string
+	collect := func(kind cgraph.ObjectTag, object string, obj interface{ IsHTML(string) bool }) {
+		for sym, err := range graph.Attributes(kind) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if obj.IsHTML(sym.Name()) {
+				values = append(values, object+" "+sym.Name())
+			}
+		}
+	}
+	var walk func(*cgraph.Graph)
+	walk = func(g *cgraph.Graph) {
+		name, err := g.Name()
+		if err != nil {
+			t.Fatal(err)
+		}
+		collect(cgraph.GRAPH, "graph "+name, g)
+		for sub, err := range g.SubGraphs() {
+			if err != nil {
+				t.Fatal(err)
+			}
+			walk(sub)
+		}
+	}
+	walk(graph)
+	for n, err := range graph.Nodes() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		name, err := n.Name()
+		if err != nil {
+			t.Fatal(err)
+		}
+		collect(cgraph.NODE, "node "+name, n)
+	}
+	for e, err := range graph.Edges() {
+		if err != nil {
+			t.Fatal(err)
+		}
+		tail, err := e.Tail()
+		if err != nil {
+			t.Fatal(err)
+		}
+		head, err := e.Head()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tailName, _ := tail.Name()
+		headName, _ := head.Name()
+		collect(cgraph.EDGE, "edge "+tailName+" -> "+headName, e)
+	}
+	slices.Sort(values)
+	return values
+}
+
+func TestConvert(t *testing.T) {
+	formats := []struct {
+		name  string
+		write func(io.Writer, *cgraph.Graph) error
+		parse func(io.Reader) (*cgraph.Graph, error)
+	}{
+		{name: "gml", write: convert.WriteGML, parse: convert.ParseGML},
+		{name: "gxl", write: convert.WriteGXL, parse: convert.ParseGXL},
+	}
+	for _, path := range testPaths {
+		files, err := os.ReadDir(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range files {
+			graph, err := graphviz.ParseFile(filepath.Join(path, file.Name()))
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, format := range formats {
+				t.Run(fmt.Sprintf("%s/%s", file.Name(), format.name), func(t *testing.T) {
+					var buf bytes.Buffer
+					if err := format.write(&buf, graph); err != nil {
+						t.Fatal(err)
+					}
+					parsed, err := format.parse(&buf)
+					if err != nil {
+						t.Fatal(err)
+					}
+					defer parsed.Close()
+					if format.name == "gxl" && graph.GetStr("charset") == "latin1" {
+						// XML documents cannot have Latin-1 strings, so they are converted to UTF-8.
+						if parsed.GetStr("charset") != "UTF-8" {
+							t.Fatalf("expected charset UTF-8 but got %q", parsed.GetStr("charset"))
+						}
+						for n, err := range parsed.Nodes() {
+							if err != nil {
+								t.Fatal(err)
+							}
+							if !utf8.ValidString(n.GetStr("label")) {
+								t.Fatalf("expected a label in UTF-8 but got %q", n.GetStr("label"))
+							}
+						}
+						return
+					}
+					diff, err := cgraph.Diff(graph, parsed)
+					if err != nil {
+						t.Fatal(err)
+					}
+					// a node in sibling subgraphs is written in one of them because the formats hold a node in only one graph.
+					changed := diff.ChangedSubGraphs
+					diff.ChangedSubGraphs = nil
+					for _, c := range changed {
+						if len(c.Changes) > 0 || len(c.AddedNodes) > 0 {
+							diff.ChangedSubGraphs = append(diff.ChangedSubGraphs, c)
+						}
+					}
+					if !diff.Empty() {
+						t.Fatalf("unexpected difference after the round trip:\n%s", diff)
+					}
+					if expected, got := htmlValues(t, graph), htmlValues(t, parsed); !slices.Equal(expected, got) {
+						t.Fatalf("expected HTML strings %v but got %v\n%s", expected, got, buf.String())
+					}
+				})
+			}
+			graph.Close()
+		}
+	}
+
+	t.Run("legacy gml", func(t *testing.T) {
+		graph, err := convert.ParseGML(strings.NewReader(`
+Creator "yEd"
+graph [
+  directed 1
+  node [ id 0 label "group" isGroup 1 ]
+  node [ id 1 label "a" gid 0 graphics [ x 10.0 y 20.0 w 72.0 h 36.0 type "box" fill "#FF0000" ] ]
+  node [ id 2 label "b &quot;quoted&quot;" ]
+  edge [ source 1 target 2 graphics [ fill "#0000FF" arrow "both" ] ]
+  edge [ source 1 target 2 ]
+]`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		a, err := graph.NodeByName("1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a == nil || a.GetStr("label") != "a" || a.GetStr("pos") != "10.0,20.0" || a.GetStr("width") != "1" ||
+			a.GetStr("shape") != "box" || a.GetStr("fillcolor") != "#FF0000" {
+			t.Fatal("unexpected attributes of node 1")
+		}
+		b, err := graph.NodeByName("2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if b == nil || b.GetStr("label") != `b "quoted"` {
+			t.Fatal("expected the label of node 2 to be unescaped")
+		}
+		group, err := graph.SubGraphByName("0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if group == nil || group.GetStr("label") != "group" {
+			t.Fatal("expected the group as the subgraph 0")
+		}
+		if n, err := group.NodeByName("1"); err != nil || n == nil {
+			t.Fatal("expected node 1 in the subgraph 0")
+		}
+		edges, err := graph.EdgeNum()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if edges != 2 {
+			t.Fatalf("expected 2 parallel edges but got %d", edges)
+		}
+		for e, err := range graph.OutEdges(a) {
+			if err != nil {
+				t.Fatal(err)
+			}
+			if e.GetStr("color") == "#0000FF" && e.GetStr("dir") != "both" {
+				t.Fatalf("expected dir=both but got %q", e.GetStr("dir"))
+			}
+		}
+		if _, err := convert.ParseGML(strings.NewReader(`graph [ edge [ source 0 target 1 ] ]`)); !errors.Is(err, convert.ErrGML) {
+			t.Fatalf("expected ErrGML for an unknown node but got %v", err)
+		}
+	})
+
+	t.Run("legacy gxl", func(t *testing.T) {
+		graph, err := convert.ParseGXL(strings.NewReader(`<?xml version="1.0"?>
+<gxl xmlns:xlink="http://www.w3.org/1999/xlink">
+  <graph id="G" edgemode="undirected">
+    <node id="a"><attr name="weight"><int> 3 </int></attr><attr name="tags"><seq><string>x</string><string>y</string></seq></attr></node>
+    <node id="b"/>
+    <edge id="e1" from="a" to="b"><attr name="label"><string>ab</string></attr></edge>
+  </graph>
+</gxl>`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		directed, err := graph.IsDirected()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if directed {
+			t.Fatal("expected an undirected graph")
+		}
+		a, err := graph.NodeByName("a")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if a.GetStr("weight") != "3" || a.GetStr("tags") != "x,y" {
+			t.Fatalf("unexpected attributes weight=%q tags=%q", a.GetStr("weight"), a.GetStr("tags"))
+		}
+		for e, err := range graph.Edges() {
+			if err != nil {
+				t.Fatal(err)
+			}
+			key, err := e.Name()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if key != "" || e.GetStr("id") != "e1" || e.GetStr("label") != "ab" {
+				t.Fatalf("unexpected edge key=%q id=%q label=%q", key, e.GetStr("id"), e.GetStr("label"))
+			}
+		}
+	})
+}
+
+func TestExport(t *testing.T) {
+	graph, err := graphviz.ParseBytes([]byte(`digraph G {
+  label="Pipeline"
+  rankdir=LR
+  fontname="Helvetica"
+  node [shape=box]
+  subgraph cluster_build {
+    label="Build"
+    style=filled
+    fillcolor=lightgrey
+    compile [label="compile \"src\""]
+    test [shape=ellipse, color=red]
+  }
+  deploy [shape=cylinder, style=filled, fillcolor="#00ff00"]
+  star [shape=star]
+  compile -> test [label="ok", style=dashed]
+  test -> deploy [color=blue, penwidth=2]
+  deploy -> star [dir=both, arrowhead=dot]
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer graph.Close()
+	unsupported := func(t *testing.T, reports []*convert.Unsupported, name, value string) {
+		t.Helper()
+		for _, u := range reports {
+			if u.Name == name && u.Value == value {
+				return
+			}
+		}
+		t.Fatalf("expected %s=%q to be reported but got %v", name, value, reports)
+	}
+
+	t.Run("mermaid", func(t *testing.T) {
+		var buf bytes.Buffer
+		reports, err := convert.WriteMermaid(&buf, graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, expected := range []string{
+			"title: \"Pipeline\"\n",
+			"flowchart LR\n",
+			`subgraph c0["Build"]`,
+			`n0["compile #quot;src#quot;"]`,
+			`n1(["test"])`,
+			`n2[("deploy")]`,
+			`n0 -.->|"ok"| n1`,
+			"n1 --> n2",
+			"n2 <--> n3",
+			"linkStyle 1 stroke:#0000ff,stroke-width:2px",
+			"style n2 fill:#00ff00",
+			"style c0 fill:#d3d3d3",
+		} {
+			if !strings.Contains(out, expected) {
+				t.Fatalf("expected %q in the output:\n%s", expected, out)
+			}
+		}
+		unsupported(t, reports, "fontname", "Helvetica")
+		unsupported(t, reports, "shape", "star")
+		unsupported(t, reports, "arrowhead", "dot")
+	})
+
+	t.Run("plantuml component", func(t *testing.T) {
+		var buf bytes.Buffer
+		reports, err := convert.WritePlantUML(&buf, graph, convert.PlantUMLComponent)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, expected := range []string{
+			"@startuml\ntitle Pipeline\nleft to right direction\n",
+			`rectangle "Build" as c0 #d3d3d3 {`,
+			`rectangle "compile &#34;src&#34;" as n0`,
+			`usecase "test" as n1 #line:ff0000`,
+			`database "deploy" as n2 #00ff00`,
+			"n0 -[dashed]-> n1 : ok",
+			"n1 -[#0000ff,thickness=2]-> n2",
+			"n2 <--> n3",
+			"@enduml\n",
+		} {
+			if !strings.Contains(out, expected) {
+				t.Fatalf("expected %q in the output:\n%s", expected, out)
+			}
+		}
+		unsupported(t, reports, "fontname", "Helvetica")
+		unsupported(t, reports, "shape", "star")
+	})
+
+	t.Run("plantuml activity", func(t *testing.T) {
+		var buf bytes.Buffer
+		reports, err := convert.WritePlantUML(&buf, graph, convert.PlantUMLActivity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, expected := range []string{
+			"partition \"Build\" #d3d3d3 {\n  \"compile &#34;src&#34;\" as n0 -[dashed]->[ok] \"test\" as n1\n}\n",
+			"n1 -[#0000ff,bold]-> \"deploy\" as n2",
+		} {
+			if !strings.Contains(out, expected) {
+				t.Fatalf("expected %q in the output:\n%s", expected, out)
+			}
+		}
+		unsupported(t, reports, "rankdir", "LR")
+		unsupported(t, reports, "dir", "both")
+		unsupported(t, reports, "color", "#ff0000")
+	})
+
+	if _, err := convert.WritePlantUML(io.Discard, graph, "sequence"); err == nil {
+		t.Fatal("expected an error for an unknown diagram")
+	}
+
+	t.Run("html labels", func(t *testing.T) {
+		graph, err := graphviz.ParseBytes([]byte(`digraph { a [label=<foo <b>bar</b> baz>]; b [label="<x>"] }`))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer graph.Close()
+		var buf bytes.Buffer
+		reports, err := convert.WriteMermaid(&buf, graph)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out := buf.String()
+		for _, expected := range []string{`n0(["foo bar baz"])`, `n1(["#lt;x#gt;"])`} {
+			if !strings.Contains(out, expected) {
+				t.Fatalf("expected %q in the output:\n%s", expected, out)
+			}
+		}
+		unsupported(t, reports, "label", "foo <b>bar</b> baz")
+		for _, u := range reports {
+			if u.Value == "<x>" {
+				t.Fatalf("expected the quoted label not to be reported but got %v", u)
+			}
+		}
+	})
+
+	for _, path := range testPaths {
+		files, err := os.ReadDir(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, file := range files {
+			t.Run(file.Name(), func(t *testing.T) {
+				graph, err := graphviz.ParseFile(filepath.Join(path, file.Name()))
+				if err != nil {
+					t.Fatal(err)
+				}
+				defer graph.Close()
+				if _, err := convert.WriteMermaid(io.Discard, graph); err != nil {
+					t.Fatal(err)
+				}
+				for _, diagram := range []convert.PlantUMLDiagram{convert.PlantUMLComponent, convert.PlantUMLActivity} {
+					if _, err := convert.WritePlantUML(io.Discard, graph, diagram); err != nil {
+						t.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
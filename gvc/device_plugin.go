@@ -58,11 +58,15 @@ func NewDevicePlugin(ctx context.Context, typ string, opts ...DevicePluginOption
 }
 
 func PNGDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
-	return newDevicePlugin(ctx, defaultDevicePluginConfig("png:png"))
+	cfg := defaultDevicePluginConfig("png:png")
+	cfg.Features = append(cfg.Features, DeviceDoesPages, DeviceDoesLayers)
+	return newDevicePlugin(ctx, cfg)
 }
 
 func JPGDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
-	return newDevicePlugin(ctx, defaultDevicePluginConfig("jpg:jpg"))
+	cfg := defaultDevicePluginConfig("jpg:jpg")
+	cfg.Features = append(cfg.Features, DeviceDoesPages, DeviceDoesLayers)
+	return newDevicePlugin(ctx, cfg)
 }
 
 type deviceDPI struct {
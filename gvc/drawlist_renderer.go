@@ -0,0 +1,285 @@
+package gvc
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+// DrawList is the document generated by the drawlist format.
+// All coordinates are in device pixels with the origin at the top left corner,
+// so the primitives can be replayed directly on an HTML5 canvas.
+type DrawList struct {
+	Width   float64       `json:"width"`
+	Height  float64       `json:"height"`
+	Objects []*DrawObject `json:"objects"`
+}
+
+// DrawObject is a graph, cluster, node or edge together with everything drawn for it, in drawing order.
+type DrawObject struct {
+	Kind        string           `json:"kind"`
+	ID          string           `json:"id,omitempty"`
+	Name        string           `json:"name,omitempty"`
+	Class       string           `json:"class,omitempty"`
+	Style       []string         `json:"style,omitempty"`
+	BoundingBox [4]float64       `json:"bbox"`
+	Primitives  []*DrawPrimitive `json:"primitives,omitempty"`
+	Texts       []*DrawText      `json:"texts,omitempty"`
+}
+
+// DrawPrimitive is a single shape.
+//
+// For "ellipse", Points contains the center followed by the x and y radius.
+// For "bezier", Points contains the start point followed by control points and end point triples.
+// "polygon" and "polyline" contain the vertices.
+type DrawPrimitive struct {
+	Type      string       `json:"type"`
+	Points    [][2]float64 `json:"points"`
+	Filled    bool         `json:"filled,omitempty"`
+	PenColor  string       `json:"penColor"`
+	FillColor string       `json:"fillColor,omitempty"`
+	PenWidth  float64      `json:"penWidth"`
+	Pen       string       `json:"pen"`
+}
+
+// DrawText is a text run. X and Y is the baseline point specified by Anchor.
+type DrawText struct {
+	Text   string   `json:"text"`
+	X      float64  `json:"x"`
+	Y      float64  `json:"y"`
+	Anchor string   `json:"anchor"`
+	Width  float64  `json:"width"`
+	Font   DrawFont `json:"font"`
+}
+
+type DrawFont struct {
+	Name  string  `json:"name"`
+	Size  float64 `json:"size"`
+	Color string  `json:"color"`
+}
+
+const (
+	DrawPrimitiveEllipse  = "ellipse"
+	DrawPrimitivePolygon  = "polygon"
+	DrawPrimitiveBezier   = "bezier"
+	DrawPrimitivePolyline = "polyline"
+)
+
+// DrawListRenderer renders a graph as DrawList JSON document.
+type DrawListRenderer struct {
+	*ObjectRenderEngine
+	list    *DrawList
+	objects []*DrawObject
+}
+
+func newDrawListRenderEngine() *DrawListRenderer {
+	r := &DrawListRenderer{}
+	r.ObjectRenderEngine = NewObjectRenderEngine(r)
+	return r
+}
+
+func DrawListRenderPlugin(ctx context.Context) (*RenderPlugin, error) {
+	cfg := defaultRenderPluginConfig("drawlist", newDrawListRenderEngine())
+	cfg.Features = append(cfg.Features, RenderDoesMaps)
+	return newRenderPlugin(ctx, cfg)
+}
+
+func DrawListDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
+	cfg := defaultDevicePluginConfig("drawlist:drawlist")
+	cfg.Features = []DeviceFeature{DeviceDoesTrueColor}
+	return newDevicePlugin(ctx, cfg)
+}
+
+func (r *DrawListRenderer) BeginJob(ctx context.Context, job *Job) error {
+	r.list = &DrawList{Objects: []*DrawObject{}}
+	r.objects = nil
+	return nil
+}
+
+func (r *DrawListRenderer) BeginPage(ctx context.Context, job *Job) error {
+	r.list.Width = float64(job.Width())
+	r.list.Height = float64(job.Height())
+	return nil
+}
+
+func (r *DrawListRenderer) EndJob(ctx context.Context, job *Job) error {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(r.list); err != nil {
+		return err
+	}
+	job.SetOutputData(buf.Bytes())
+	job.SetOutputDataPosition(uint(buf.Len()))
+	return nil
+}
+
+func (r *DrawListRenderer) BeginObject(ctx context.Context, job *Job, obj *RenderObject) error {
+	o := &DrawObject{
+		Kind:  drawObjectKind(obj.Type),
+		ID:    obj.ID,
+		Name:  obj.Name,
+		Class: obj.Class,
+		Style: job.Object().RawStyle(),
+	}
+	r.list.Objects = append(r.list.Objects, o)
+	r.objects = append(r.objects, o)
+	return nil
+}
+
+func (r *DrawListRenderer) EndObject(ctx context.Context, job *Job, obj *RenderObject) error {
+	o := r.objects[len(r.objects)-1]
+	r.objects = r.objects[:len(r.objects)-1]
+	if !obj.BoundingBox.Empty() {
+		// y goes down in device space, so the upper right corner becomes the top of the box.
+		x0, y0 := r.toDevice(job, obj.BoundingBox.LLX, obj.BoundingBox.URY)
+		x1, y1 := r.toDevice(job, obj.BoundingBox.URX, obj.BoundingBox.LLY)
+		o.BoundingBox = [4]float64{x0, y0, x1, y1}
+	}
+	return nil
+}
+
+func (r *DrawListRenderer) current() *DrawObject {
+	if len(r.objects) == 0 {
+		return nil
+	}
+	return r.objects[len(r.objects)-1]
+}
+
+func (r *DrawListRenderer) toDevice(job *Job, x, y float64) (float64, float64) {
+	scale := job.Scale()
+	translation := job.Translation()
+	return scale.X() * (x + translation.X()), -scale.Y() * (y + translation.Y())
+}
+
+func (r *DrawListRenderer) devicePoints(job *Job, points []*PointFloat) [][2]float64 {
+	ret := make([][2]float64, 0, len(points))
+	for _, p := range points {
+		x, y := r.toDevice(job, p.X(), p.Y())
+		ret = append(ret, [2]float64{x, y})
+	}
+	return ret
+}
+
+func (r *DrawListRenderer) addPrimitive(job *Job, typ string, points [][2]float64, filled bool) {
+	o := r.current()
+	if o == nil {
+		return
+	}
+	obj := job.Object()
+	p := &DrawPrimitive{
+		Type:     typ,
+		Points:   points,
+		Filled:   filled,
+		PenColor: drawColor(obj.PenColor()),
+		PenWidth: obj.PenWidth() * job.Zoom(),
+		Pen:      drawPen(obj.Pen()),
+	}
+	if filled {
+		p.FillColor = drawColor(obj.FillColor())
+	}
+	o.Primitives = append(o.Primitives, p)
+}
+
+func (r *DrawListRenderer) TextSpan(ctx context.Context, job *Job, p *PointFloat, span *TextSpan) error {
+	if err := r.ObjectRenderEngine.TextSpan(ctx, job, p, span); err != nil {
+		return err
+	}
+	o := r.current()
+	if o == nil {
+		return nil
+	}
+	x, y := r.toDevice(job, p.X(), p.Y()+span.YOffsetCenterLine())
+	anchor := "middle"
+	switch span.Just() {
+	case 'l':
+		anchor = "start"
+	case 'r':
+		anchor = "end"
+	}
+	font := span.Font()
+	o.Texts = append(o.Texts, &DrawText{
+		Text:   span.Text(),
+		X:      x,
+		Y:      y,
+		Anchor: anchor,
+		Width:  span.Size().X() * job.Scale().X(),
+		Font: DrawFont{
+			Name:  font.Name(),
+			Size:  font.Size() * job.Zoom(),
+			Color: drawColor(job.Object().PenColor()),
+		},
+	})
+	return nil
+}
+
+func (r *DrawListRenderer) Ellipse(ctx context.Context, job *Job, points []*PointFloat, filled bool) error {
+	if err := r.ObjectRenderEngine.Ellipse(ctx, job, points, filled); err != nil {
+		return err
+	}
+	if len(points) < 2 {
+		return nil
+	}
+	cx, cy := r.toDevice(job, points[0].X(), points[0].Y())
+	rx := (points[1].X() - points[0].X()) * job.Scale().X()
+	ry := (points[1].Y() - points[0].Y()) * job.Scale().Y()
+	r.addPrimitive(job, DrawPrimitiveEllipse, [][2]float64{{cx, cy}, {rx, ry}}, filled)
+	return nil
+}
+
+func (r *DrawListRenderer) Polygon(ctx context.Context, job *Job, points []*PointFloat, filled bool) error {
+	if err := r.ObjectRenderEngine.Polygon(ctx, job, points, filled); err != nil {
+		return err
+	}
+	r.addPrimitive(job, DrawPrimitivePolygon, r.devicePoints(job, points), filled)
+	return nil
+}
+
+func (r *DrawListRenderer) BezierCurve(ctx context.Context, job *Job, points []*PointFloat, filled bool) error {
+	if err := r.ObjectRenderEngine.BezierCurve(ctx, job, points, filled); err != nil {
+		return err
+	}
+	r.addPrimitive(job, DrawPrimitiveBezier, r.devicePoints(job, points), filled)
+	return nil
+}
+
+func (r *DrawListRenderer) Polyline(ctx context.Context, job *Job, points []*PointFloat) error {
+	if err := r.ObjectRenderEngine.Polyline(ctx, job, points); err != nil {
+		return err
+	}
+	r.addPrimitive(job, DrawPrimitivePolyline, r.devicePoints(job, points), false)
+	return nil
+}
+
+func drawObjectKind(typ ObjectType) string {
+	switch typ {
+	case RootGraphObjectType:
+		return "graph"
+	case ClusterObjectType:
+		return "cluster"
+	case NodeObjectType:
+		return "node"
+	case EdgeObjectType:
+		return "edge"
+	}
+	return ""
+}
+
+func drawPen(pen PenType) string {
+	switch pen {
+	case PenDashed:
+		return "dashed"
+	case PenDotted:
+		return "dotted"
+	case PenNone:
+		return "none"
+	}
+	return "solid"
+}
+
+func drawColor(c *Color) string {
+	if c == nil {
+		return ""
+	}
+	rgba := c.RGBAUint()
+	return fmt.Sprintf("#%02x%02x%02x%02x", rgba[0], rgba[1], rgba[2], rgba[3])
+}
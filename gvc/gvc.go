@@ -84,19 +84,46 @@ func (c *Context) RenderImage(ctx context.Context, g *cgraph.Graph, format strin
 	return img, nil
 }
 
-func (c *Context) RenderFilename(ctx context.Context, g *cgraph.Graph, format, filename string) error {
-	if _, err := os.Stat(filename); err != nil {
-		// file does not exist.
-		// Since gvc.RenderFilename fails if the file doesn't exist, we create it beforehand.
-		if _, err := os.Create(filename); err != nil {
-			return fmt.Errorf("failed to create file: %w", err)
+// RenderImages renders every page of the graph.
+// The result contains multiple images only if the graph is split into pages by the page attribute.
+func (c *Context) RenderImages(ctx context.Context, g *cgraph.Graph, format string) ([]image.Image, error) {
+	var buf bytes.Buffer
+	if err := c.RenderData(ctx, g, format, &buf); err != nil {
+		return nil, err
+	}
+	pages := splitPages(format, buf.Bytes())
+	images := make([]image.Image, 0, len(pages))
+	for _, page := range pages {
+		img, _, err := image.Decode(bytes.NewReader(page))
+		if err != nil {
+			return nil, err
 		}
+		images = append(images, img)
 	}
-	res, err := c.gvc.RenderFilename(ctx, toGraphWasm(g), format, filename)
-	if err != nil {
+	return images, nil
+}
+
+// RenderFilename renders the graph and writes it to filename.
+// If the graph is split into multiple pages, every page is written to the file named by PageFileName instead.
+func (c *Context) RenderFilename(ctx context.Context, g *cgraph.Graph, format, filename string) error {
+	// The file system of the wasm module is read-only, so the output is written from Go.
+	var buf bytes.Buffer
+	if err := c.RenderData(ctx, g, format, &buf); err != nil {
 		return err
 	}
-	return toError(res)
+	pages := splitPages(format, buf.Bytes())
+	if len(pages) == 1 {
+		if err := os.WriteFile(filename, pages[0], 0o644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+		return nil
+	}
+	for i, page := range pages {
+		if err := os.WriteFile(PageFileName(filename, i+1), page, 0o644); err != nil {
+			return fmt.Errorf("failed to write file: %w", err)
+		}
+	}
+	return nil
 }
 
 func (c *Context) FreeLayout(ctx context.Context, g *cgraph.Graph) error {
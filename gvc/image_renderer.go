@@ -8,6 +8,7 @@ import (
 	"image/jpeg"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 
@@ -31,7 +32,8 @@ var (
 
 type ImageRenderer struct {
 	*DefaultRenderEngine
-	ctx *gg.Context
+	ctx   *gg.Context
+	pages [][]byte
 }
 
 func (r *ImageRenderer) toX(job *Job, x float64) float64 {
@@ -64,15 +66,6 @@ func (r *ImageRenderer) encodeJPG(w io.Writer) error {
 	})
 }
 
-func (r *ImageRenderer) saveJPG(path string) error {
-	file, err := os.Create(path)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-	return r.encodeJPG(file)
-}
-
 func (r *ImageRenderer) setPenStyle(job *Job) {
 	o := job.Object()
 	switch o.Pen() {
@@ -85,6 +78,13 @@ func (r *ImageRenderer) setPenStyle(job *Job) {
 	r.ctx.SetLineWidth(o.PenWidth())
 }
 
+func (r *ImageRenderer) BeginJob(ctx context.Context, job *Job) error {
+	r.pages = nil
+	return nil
+}
+
+// EndPage encodes the page and appends it to the output data.
+// The pages are split again by RenderImages and RenderFilename, which writes every page as a numbered file.
 func (r *ImageRenderer) EndPage(ctx context.Context, job *Job) error {
 	var buf bytes.Buffer
 	switch {
@@ -97,25 +97,20 @@ func (r *ImageRenderer) EndPage(ctx context.Context, job *Job) error {
 			return err
 		}
 	}
-	job.SetOutputData(buf.Bytes())
-	job.SetOutputDataPosition(uint(len(buf.Bytes())))
-
-	filename := job.OutputFileName()
-	if filename != "" {
-		switch {
-		case r.isPNG(job):
-			if err := r.ctx.SavePNG(filename); err != nil {
-				return err
-			}
-		case r.isJPG(job):
-			if err := r.saveJPG(filename); err != nil {
-				return err
-			}
-		}
-	}
+	r.pages = append(r.pages, buf.Bytes())
+	data := bytes.Join(r.pages, nil)
+	job.SetOutputData(data)
+	job.SetOutputDataPosition(uint(len(data)))
 	return nil
 }
 
+// PageFileName returns the file name used for the page-th page ( starting with 1 ) of a multi-page output.
+// e.g. PageFileName("graph.png", 2) returns "graph_2.png".
+func PageFileName(filename string, page int) string {
+	ext := filepath.Ext(filename)
+	return fmt.Sprintf("%s_%d%s", strings.TrimSuffix(filename, ext), page, ext)
+}
+
 func (r *ImageRenderer) TextSpan(ctx context.Context, job *Job, p *PointFloat, span *TextSpan) error {
 	r.ctx.Push()
 	defer r.ctx.Pop()
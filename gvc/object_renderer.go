@@ -0,0 +1,338 @@
+package gvc
+
+import (
+	"context"
+	"math"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// ObjectRenderer is a higher-level alternative to RenderEngine.
+// Instead of drawing primitives, it receives every graph, cluster, node and edge
+// together with its identity, attributes and the bounding box of everything drawn for it.
+// Use NewObjectRenderEngine to register it with NewRenderPlugin.
+type ObjectRenderer interface {
+	// BeginObject is called before the object is drawn. BoundingBox is not known yet.
+	BeginObject(ctx context.Context, job *Job, obj *RenderObject) error
+	// EndObject is called after the object is drawn with its BoundingBox filled in.
+	EndObject(ctx context.Context, job *Job, obj *RenderObject) error
+}
+
+// BoundingBox rectangle in graph coordinates ( points, y goes up ).
+type BoundingBox struct {
+	LLX float64
+	LLY float64
+	URX float64
+	URY float64
+}
+
+// Empty reports whether nothing has been drawn into the box.
+func (b BoundingBox) Empty() bool {
+	return b.LLX > b.URX || b.LLY > b.URY
+}
+
+func (b BoundingBox) Width() float64 {
+	if b.Empty() {
+		return 0
+	}
+	return b.URX - b.LLX
+}
+
+func (b BoundingBox) Height() float64 {
+	if b.Empty() {
+		return 0
+	}
+	return b.URY - b.LLY
+}
+
+func (b *BoundingBox) add(x, y float64) {
+	b.LLX = math.Min(b.LLX, x)
+	b.LLY = math.Min(b.LLY, y)
+	b.URX = math.Max(b.URX, x)
+	b.URY = math.Max(b.URY, y)
+}
+
+func (b *BoundingBox) union(v BoundingBox) {
+	if v.Empty() {
+		return
+	}
+	b.add(v.LLX, v.LLY)
+	b.add(v.URX, v.URY)
+}
+
+func emptyBoundingBox() BoundingBox {
+	return BoundingBox{
+		LLX: math.Inf(1),
+		LLY: math.Inf(1),
+		URX: math.Inf(-1),
+		URY: math.Inf(-1),
+	}
+}
+
+// RenderObject describes the graph object currently being rendered.
+type RenderObject struct {
+	Type ObjectType
+	// Name is the graph, cluster or node name.
+	// For edges it is the edge key if any, otherwise "tail -> head" ( or "tail -- head" for undirected graphs ).
+	Name string
+	// ID is the id generated by Graphviz or specified with the id attribute.
+	// It is only available if the render plugin has RenderDoesMaps feature.
+	ID    string
+	Class string
+	// Attributes contains every non-empty attribute of the object.
+	Attributes  map[string]string
+	BoundingBox BoundingBox
+
+	// Graph is set for RootGraphObjectType and ClusterObjectType.
+	Graph *cgraph.Graph
+	// Node is set for NodeObjectType.
+	Node *cgraph.Node
+	// Edge is set for EdgeObjectType.
+	Edge *cgraph.Edge
+}
+
+// ObjectRenderEngine is a RenderEngine that drives an ObjectRenderer.
+type ObjectRenderEngine struct {
+	*DefaultRenderEngine
+	renderer ObjectRenderer
+	stack    []*RenderObject
+}
+
+func NewObjectRenderEngine(renderer ObjectRenderer) *ObjectRenderEngine {
+	return &ObjectRenderEngine{
+		DefaultRenderEngine: new(DefaultRenderEngine),
+		renderer:            renderer,
+	}
+}
+
+func (e *ObjectRenderEngine) begin(ctx context.Context, job *Job) error {
+	obj, err := newRenderObject(job.Object())
+	if err != nil {
+		return err
+	}
+	e.stack = append(e.stack, obj)
+	return e.renderer.BeginObject(ctx, job, obj)
+}
+
+func (e *ObjectRenderEngine) end(ctx context.Context, job *Job) error {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	obj := e.stack[len(e.stack)-1]
+	e.stack = e.stack[:len(e.stack)-1]
+	if len(e.stack) != 0 {
+		e.stack[len(e.stack)-1].BoundingBox.union(obj.BoundingBox)
+	}
+	return e.renderer.EndObject(ctx, job, obj)
+}
+
+func (e *ObjectRenderEngine) current() *RenderObject {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	return e.stack[len(e.stack)-1]
+}
+
+func (e *ObjectRenderEngine) addPoints(points []*PointFloat) {
+	if len(e.stack) == 0 {
+		return
+	}
+	box := &e.stack[len(e.stack)-1].BoundingBox
+	for _, p := range points {
+		box.add(p.X(), p.Y())
+	}
+}
+
+func (e *ObjectRenderEngine) BeginGraph(ctx context.Context, job *Job) error {
+	return e.begin(ctx, job)
+}
+
+func (e *ObjectRenderEngine) EndGraph(ctx context.Context, job *Job) error {
+	return e.end(ctx, job)
+}
+
+func (e *ObjectRenderEngine) BeginCluster(ctx context.Context, job *Job) error {
+	return e.begin(ctx, job)
+}
+
+func (e *ObjectRenderEngine) EndCluster(ctx context.Context, job *Job) error {
+	return e.end(ctx, job)
+}
+
+func (e *ObjectRenderEngine) BeginNode(ctx context.Context, job *Job) error {
+	return e.begin(ctx, job)
+}
+
+func (e *ObjectRenderEngine) EndNode(ctx context.Context, job *Job) error {
+	return e.end(ctx, job)
+}
+
+func (e *ObjectRenderEngine) BeginEdge(ctx context.Context, job *Job) error {
+	return e.begin(ctx, job)
+}
+
+func (e *ObjectRenderEngine) EndEdge(ctx context.Context, job *Job) error {
+	return e.end(ctx, job)
+}
+
+func (e *ObjectRenderEngine) TextSpan(_ context.Context, _ *Job, p *PointFloat, span *TextSpan) error {
+	if len(e.stack) == 0 {
+		return nil
+	}
+	// The text extent is approximated by the span size placed on the baseline.
+	size := span.Size()
+	x := p.X()
+	switch span.Just() {
+	case 'r':
+		x -= size.X()
+	case 'l':
+	default:
+		x -= size.X() / 2.0
+	}
+	y := p.Y() + span.YOffsetCenterLine()
+	box := &e.stack[len(e.stack)-1].BoundingBox
+	box.add(x, y-size.Y()/2.0)
+	box.add(x+size.X(), y+size.Y()/2.0)
+	return nil
+}
+
+func (e *ObjectRenderEngine) Ellipse(_ context.Context, _ *Job, points []*PointFloat, _ bool) error {
+	if len(e.stack) == 0 || len(points) < 2 {
+		return nil
+	}
+	// points[0] is the center and points[1] is the corner of the enclosing rectangle.
+	cx, cy := points[0].X(), points[0].Y()
+	rx, ry := math.Abs(points[1].X()-cx), math.Abs(points[1].Y()-cy)
+	box := &e.stack[len(e.stack)-1].BoundingBox
+	box.add(cx-rx, cy-ry)
+	box.add(cx+rx, cy+ry)
+	return nil
+}
+
+func (e *ObjectRenderEngine) Polygon(_ context.Context, _ *Job, points []*PointFloat, _ bool) error {
+	e.addPoints(points)
+	return nil
+}
+
+func (e *ObjectRenderEngine) BezierCurve(_ context.Context, _ *Job, points []*PointFloat, _ bool) error {
+	e.addPoints(points)
+	return nil
+}
+
+func (e *ObjectRenderEngine) Polyline(_ context.Context, _ *Job, points []*PointFloat) error {
+	e.addPoints(points)
+	return nil
+}
+
+func (e *ObjectRenderEngine) LibraryShape(_ context.Context, _ *Job, _ string, points []*PointFloat, _ bool) error {
+	e.addPoints(points)
+	return nil
+}
+
+func (e *ObjectRenderEngine) LoadImage(_ context.Context, _ *Job, _ *UserShape, box *BoxFloat, _ bool) error {
+	e.addPoints([]*PointFloat{box.LL(), box.UR()})
+	return nil
+}
+
+func newRenderObject(state *ObjectState) (*RenderObject, error) {
+	obj := &RenderObject{
+		Type:        state.Type(),
+		ID:          state.ID(),
+		BoundingBox: emptyBoundingBox(),
+	}
+	switch obj.Type {
+	case RootGraphObjectType, ClusterObjectType:
+		g := state.Graph()
+		name, err := g.Name()
+		if err != nil {
+			return nil, err
+		}
+		attrs, err := objectAttributes(g.GraphRoot(), cgraph.GRAPH, g.GetStr)
+		if err != nil {
+			return nil, err
+		}
+		obj.Graph = g
+		obj.Name = name
+		obj.Attributes = attrs
+	case NodeObjectType:
+		n := state.Node()
+		name, err := n.Name()
+		if err != nil {
+			return nil, err
+		}
+		attrs, err := objectAttributes(n.Root(), cgraph.NODE, n.GetStr)
+		if err != nil {
+			return nil, err
+		}
+		obj.Node = n
+		obj.Name = name
+		obj.Attributes = attrs
+	case EdgeObjectType:
+		e := state.Edge()
+		name, err := edgeName(e)
+		if err != nil {
+			return nil, err
+		}
+		tail, err := e.Tail()
+		if err != nil {
+			return nil, err
+		}
+		attrs, err := objectAttributes(tail.Root(), cgraph.EDGE, e.GetStr)
+		if err != nil {
+			return nil, err
+		}
+		obj.Edge = e
+		obj.Name = name
+		obj.Attributes = attrs
+	}
+	obj.Class = obj.Attributes["class"]
+	return obj, nil
+}
+
+func edgeName(e *cgraph.Edge) (string, error) {
+	key, err := e.Name()
+	if err != nil {
+		return "", err
+	}
+	if key != "" {
+		return key, nil
+	}
+	tail, err := e.Tail()
+	if err != nil {
+		return "", err
+	}
+	head, err := e.Head()
+	if err != nil {
+		return "", err
+	}
+	tailName, err := tail.Name()
+	if err != nil {
+		return "", err
+	}
+	headName, err := head.Name()
+	if err != nil {
+		return "", err
+	}
+	directed, err := tail.Root().IsDirected()
+	if err != nil {
+		return "", err
+	}
+	if directed {
+		return tailName + " -> " + headName, nil
+	}
+	return tailName + " -- " + headName, nil
+}
+
+func objectAttributes(root *cgraph.Graph, kind cgraph.ObjectTag, getStr func(string) string) (map[string]string, error) {
+	attrs := map[string]string{}
+	for sym, err := range root.Attributes(kind) {
+		if err != nil {
+			return nil, err
+		}
+		name := sym.Name()
+		if v := getStr(name); v != "" {
+			attrs[name] = v
+		}
+	}
+	return attrs, nil
+}
@@ -0,0 +1,100 @@
+package gvc
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+var (
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+	jpgSOI       = []byte{0xff, 0xd8}
+)
+
+// splitPages splits the output data of a multi-page rendering into pages.
+// Only png and jpg output is split because the other formats are written as a single document.
+func splitPages(format string, data []byte) [][]byte {
+	var pages [][]byte
+	for len(data) != 0 {
+		var size int
+		switch format {
+		case "png":
+			size = pngSize(data)
+		case "jpg", "jpeg":
+			size = jpgSize(data)
+		}
+		if size <= 0 || size >= len(data) {
+			return append(pages, data)
+		}
+		pages = append(pages, data[:size])
+		data = data[size:]
+	}
+	if len(pages) == 0 {
+		return [][]byte{data}
+	}
+	return pages
+}
+
+// pngSize returns the byte size of the first PNG image in data, or 0 if it's not found.
+func pngSize(data []byte) int {
+	if !bytes.HasPrefix(data, pngSignature) {
+		return 0
+	}
+	pos := len(pngSignature)
+	for pos+8 <= len(data) {
+		// chunk is length(4) + type(4) + data + crc(4).
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		typ := string(data[pos+4 : pos+8])
+		pos += 12 + length
+		if typ == "IEND" {
+			return pos
+		}
+	}
+	return 0
+}
+
+// jpgSize returns the byte size of the first JPEG image in data, or 0 if it's not found.
+// The segments are skipped by their lengths because APPn segments such as EXIF can contain
+// thumbnails with their own EOI markers before the end of the image.
+func jpgSize(data []byte) int {
+	if !bytes.HasPrefix(data, jpgSOI) {
+		return 0
+	}
+	pos := len(jpgSOI)
+	for pos+2 <= len(data) {
+		if data[pos] != 0xff {
+			return 0
+		}
+		marker := data[pos+1]
+		switch {
+		case marker == 0xff:
+			// fill byte before a marker.
+			pos++
+			continue
+		case marker == 0xd9:
+			// EOI
+			return pos + 2
+		case marker == 0x01, marker >= 0xd0 && marker <= 0xd7:
+			// TEM and RSTn have no length.
+			pos += 2
+			continue
+		}
+		if pos+4 > len(data) {
+			return 0
+		}
+		// segment is marker(2) + length(2) + data, and the length includes itself.
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		if length < 2 {
+			return 0
+		}
+		pos += 2 + length
+		if marker == 0xda {
+			// the entropy-coded data follows SOS. 0xff in it is followed by 0x00 or a RSTn marker.
+			for ; pos+1 < len(data); pos++ {
+				if next := data[pos+1]; data[pos] == 0xff && next != 0x00 && (next < 0xd0 || next > 0xd7) {
+					break
+				}
+			}
+		}
+	}
+	return 0
+}
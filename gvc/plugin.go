@@ -31,11 +31,61 @@ func DefaultPlugins(ctx context.Context) ([]Plugin, error) {
 	if err != nil {
 		return nil, err
 	}
+	drawListRenderPlugin, err := DrawListRenderPlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drawListDevicePlugin, err := DrawListDevicePlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	textRenderPlugin, err := TextRenderPlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	textDevicePlugin, err := TextDevicePlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	asciiRenderPlugin, err := ASCIIRenderPlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	asciiDevicePlugin, err := ASCIIDevicePlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sixelRenderPlugin, err := SixelRenderPlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sixelDevicePlugin, err := SixelDevicePlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kittyRenderPlugin, err := KittyRenderPlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	kittyDevicePlugin, err := KittyDevicePlugin(ctx)
+	if err != nil {
+		return nil, err
+	}
 	return []Plugin{
 		pngRenderPlugin,
 		pngDevicePlugin,
 		jpgRenderPlugin,
 		jpgDevicePlugin,
 		pngLoadImagePlugin,
+		drawListRenderPlugin,
+		drawListDevicePlugin,
+		textRenderPlugin,
+		textDevicePlugin,
+		asciiRenderPlugin,
+		asciiDevicePlugin,
+		sixelRenderPlugin,
+		sixelDevicePlugin,
+		kittyRenderPlugin,
+		kittyDevicePlugin,
 	}, nil
 }
@@ -2,6 +2,7 @@ package gvc
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/goccy/go-graphviz/cdt"
 	"github.com/goccy/go-graphviz/cgraph"
@@ -910,6 +911,23 @@ func (j *Job) SetOutputFileName(v string) {
 	j.wasm.SetOutputFilename(v)
 }
 
+// NumPages returns the number of pages the graph is split into.
+// It is greater than 1 only if the device plugin has DeviceDoesPages feature and the page attribute is set.
+func (j *Job) NumPages() int {
+	return int(j.wasm.GetNumPages())
+}
+
+// LayerNum returns the number of the layer being rendered, starting with 1.
+func (j *Job) LayerNum() int {
+	return int(j.wasm.GetLayerNum())
+}
+
+// NumLayers returns the number of layers.
+// It is greater than 1 only if the device plugin has DeviceDoesLayers feature and the layers attribute is set.
+func (j *Job) NumLayers() int {
+	return int(j.wasm.GetNumLayers())
+}
+
 func (j *Job) Object() *ObjectState {
 	return toObjectState(j.wasm.GetObj())
 }
@@ -1026,6 +1044,22 @@ func (s *ObjectState) Edge() *cgraph.Edge {
 	return toEdge(s.wasm.GetE())
 }
 
+func (s *ObjectState) ID() string {
+	return s.wasm.GetId()
+}
+
+func (s *ObjectState) Label() string {
+	return s.wasm.GetLabel()
+}
+
+func (s *ObjectState) URL() string {
+	return s.wasm.GetUrl()
+}
+
+func (s *ObjectState) Tooltip() string {
+	return s.wasm.GetTooltip()
+}
+
 type ObjectType int
 
 var (
@@ -1113,6 +1147,36 @@ func (c *Color) SetType(v ColorType) {
 	c.wasm.SetType(wasm.ColorType(v))
 }
 
+// Color converts the color to cgraph.Color according to its type.
+// A color of ColorIndex type has no component values and returns an error.
+func (c *Color) Color() (cgraph.Color, error) {
+	switch c.Type() {
+	case RGBAByte:
+		v := c.RGBAUint()
+		return cgraph.RGBA(uint8(v[0]), uint8(v[1]), uint8(v[2]), uint8(v[3])), nil
+	case RGBAWord:
+		v := c.RGBAInt()
+		return cgraph.RGBA(uint8(v[0]>>8), uint8(v[1]>>8), uint8(v[2]>>8), uint8(v[3]>>8)), nil
+	case RGBADouble:
+		v := c.RGBADouble()
+		return cgraph.RGBA(uint8(v[0]*0xff), uint8(v[1]*0xff), uint8(v[2]*0xff), uint8(v[3]*0xff)), nil
+	case HSVADouble:
+		v := c.HSVA()
+		ret := cgraph.HSV(v[0], v[1], v[2])
+		ret.A = uint8(v[3] * 0xff)
+		return ret, nil
+	case ColorString:
+		return cgraph.ParseColor(c.String())
+	}
+	return cgraph.Color{}, fmt.Errorf("unsupported color type %d", c.Type())
+}
+
+// SetColor sets the color as RGBAByte type.
+func (c *Color) SetColor(v cgraph.Color) {
+	c.SetRGBAUint([4]uint{uint(v.R), uint(v.G), uint(v.B), uint(v.A)})
+	c.SetType(RGBAByte)
+}
+
 type UserShape struct {
 	wasm *wasm.UserShape
 }
@@ -0,0 +1,164 @@
+package gvc
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+)
+
+// TerminalImageRenderer renders the graph with ImageRenderer and encodes the result
+// as an inline image escape sequence for terminals supporting the sixel or kitty graphics protocol.
+type TerminalImageRenderer struct {
+	*ImageRenderer
+	encode func(io.Writer, image.Image) error
+}
+
+func SixelRenderPlugin(ctx context.Context) (*RenderPlugin, error) {
+	return newRenderPlugin(ctx, defaultRenderPluginConfig("sixel", &TerminalImageRenderer{
+		ImageRenderer: &ImageRenderer{DefaultRenderEngine: new(DefaultRenderEngine)},
+		encode:        EncodeSixel,
+	}))
+}
+
+func SixelDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
+	cfg := defaultDevicePluginConfig("sixel:sixel")
+	cfg.Features = append(cfg.Features, DeviceDoesPages)
+	return newDevicePlugin(ctx, cfg)
+}
+
+func KittyRenderPlugin(ctx context.Context) (*RenderPlugin, error) {
+	return newRenderPlugin(ctx, defaultRenderPluginConfig("kitty", &TerminalImageRenderer{
+		ImageRenderer: &ImageRenderer{DefaultRenderEngine: new(DefaultRenderEngine)},
+		encode:        EncodeKitty,
+	}))
+}
+
+func KittyDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
+	cfg := defaultDevicePluginConfig("kitty:kitty")
+	cfg.Features = append(cfg.Features, DeviceDoesPages)
+	return newDevicePlugin(ctx, cfg)
+}
+
+// EndPage encodes the page as the escape sequence, and the pages are written one after another by EndJob.
+func (r *TerminalImageRenderer) EndPage(ctx context.Context, job *Job) error {
+	var buf bytes.Buffer
+	if err := r.encode(&buf, r.ctx.Image()); err != nil {
+		return err
+	}
+	r.pages = append(r.pages, buf.Bytes())
+	return nil
+}
+
+func (r *TerminalImageRenderer) EndJob(ctx context.Context, job *Job) error {
+	data := bytes.Join(r.pages, nil)
+	job.SetOutputData(data)
+	job.SetOutputDataPosition(uint(len(data)))
+	return nil
+}
+
+// EncodeKitty writes img as PNG using the kitty terminal graphics protocol.
+func EncodeKitty(w io.Writer, img image.Image) error {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return err
+	}
+	const chunkSize = 4096
+	data := base64.StdEncoding.EncodeToString(buf.Bytes())
+	for i := 0; i < len(data); i += chunkSize {
+		end := min(i+chunkSize, len(data))
+		more := 1
+		if end == len(data) {
+			more = 0
+		}
+		var keys string
+		if i == 0 {
+			keys = "a=T,f=100,"
+		}
+		if _, err := fmt.Fprintf(w, "\x1b_G%sm=%d;%s\x1b\\", keys, more, data[i:end]); err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// EncodeSixel writes img as sixel graphics.
+// Colors are quantized to the 6x6x6 color cube.
+func EncodeSixel(w io.Writer, img image.Image) error {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "\x1bPq\"1;1;%d;%d", width, height)
+	for i := 0; i < 216; i++ {
+		r, g, b := i/36, (i/6)%6, i%6
+		fmt.Fprintf(bw, "#%d;2;%d;%d;%d", i, r*20, g*20, b*20)
+	}
+	indexes := make([]uint8, width*height)
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			r, g, b, _ := img.At(bounds.Min.X+x, bounds.Min.Y+y).RGBA()
+			indexes[y*width+x] = uint8(sixelLevel(r)*36 + sixelLevel(g)*6 + sixelLevel(b))
+		}
+	}
+	for top := 0; top < height; top += 6 {
+		var used [216]bool
+		for y := top; y < min(top+6, height); y++ {
+			for x := 0; x < width; x++ {
+				used[indexes[y*width+x]] = true
+			}
+		}
+		first := true
+		for color := range used {
+			if !used[color] {
+				continue
+			}
+			if !first {
+				bw.WriteByte('$')
+			}
+			first = false
+			fmt.Fprintf(bw, "#%d", color)
+			var (
+				prev  byte
+				count int
+			)
+			for x := 0; x < width; x++ {
+				var bits byte
+				for dy := 0; dy < 6 && top+dy < height; dy++ {
+					if int(indexes[(top+dy)*width+x]) == color {
+						bits |= 1 << dy
+					}
+				}
+				c := 63 + bits
+				if count > 0 && c != prev {
+					writeSixelRun(bw, prev, count)
+					count = 0
+				}
+				prev = c
+				count++
+			}
+			writeSixelRun(bw, prev, count)
+		}
+		bw.WriteByte('-')
+	}
+	bw.WriteString("\x1b\\\n")
+	return bw.Flush()
+}
+
+func sixelLevel(v uint32) int {
+	return int((v*5 + 0x7fff) / 0xffff)
+}
+
+func writeSixelRun(w *bufio.Writer, c byte, count int) {
+	if count > 3 {
+		fmt.Fprintf(w, "!%d%c", count, c)
+		return
+	}
+	for i := 0; i < count; i++ {
+		w.WriteByte(c)
+	}
+}
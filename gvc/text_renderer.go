@@ -0,0 +1,424 @@
+package gvc
+
+import (
+	"context"
+	"math"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	// size of a character cell in points.
+	textCellWidth  = 7.0
+	textCellHeight = 14.0
+)
+
+// TextRenderer rasterises the layout onto a character grid for terminal previews.
+// Nodes and clusters are drawn with box-drawing characters and edges with Braille patterns.
+// If ascii is enabled, only ASCII characters are used.
+type TextRenderer struct {
+	*ObjectRenderEngine
+	ascii  bool
+	bounds BoundingBox
+	boxes  []*textBox
+	curves [][][2]float64
+	arrows []*textArrow
+	texts  []*textRun
+	shaped map[*RenderObject]*textBox
+}
+
+type textBox struct {
+	rounded bool
+	// clear erases edge fragments inside of the box. It is disabled for clusters to keep their contents.
+	clear bool
+	box   BoundingBox
+}
+
+type textArrow struct {
+	x, y   float64
+	dx, dy float64
+}
+
+type textRun struct {
+	x, y float64
+	just int
+	text string
+}
+
+func newTextRenderEngine(ascii bool) *TextRenderer {
+	r := &TextRenderer{ascii: ascii}
+	r.ObjectRenderEngine = NewObjectRenderEngine(r)
+	return r
+}
+
+func TextRenderPlugin(ctx context.Context) (*RenderPlugin, error) {
+	return newRenderPlugin(ctx, defaultRenderPluginConfig("text", newTextRenderEngine(false)))
+}
+
+func TextDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
+	cfg := defaultDevicePluginConfig("text:text")
+	cfg.Features = []DeviceFeature{DeviceDoesTrueColor}
+	return newDevicePlugin(ctx, cfg)
+}
+
+func ASCIIRenderPlugin(ctx context.Context) (*RenderPlugin, error) {
+	return newRenderPlugin(ctx, defaultRenderPluginConfig("ascii", newTextRenderEngine(true)))
+}
+
+func ASCIIDevicePlugin(ctx context.Context) (*DevicePlugin, error) {
+	cfg := defaultDevicePluginConfig("ascii:ascii")
+	cfg.Features = []DeviceFeature{DeviceDoesTrueColor}
+	return newDevicePlugin(ctx, cfg)
+}
+
+func (r *TextRenderer) BeginJob(ctx context.Context, job *Job) error {
+	r.bounds = emptyBoundingBox()
+	r.boxes = nil
+	r.curves = nil
+	r.arrows = nil
+	r.texts = nil
+	r.shaped = map[*RenderObject]*textBox{}
+	return nil
+}
+
+func (r *TextRenderer) EndJob(ctx context.Context, job *Job) error {
+	out := []byte(r.render())
+	job.SetOutputData(out)
+	job.SetOutputDataPosition(uint(len(out)))
+	return nil
+}
+
+func (r *TextRenderer) BeginObject(ctx context.Context, job *Job, obj *RenderObject) error {
+	return nil
+}
+
+func (r *TextRenderer) EndObject(ctx context.Context, job *Job, obj *RenderObject) error {
+	if obj.Type == RootGraphObjectType {
+		r.bounds.union(obj.BoundingBox)
+	}
+	if b, exists := r.shaped[obj]; exists {
+		b.box = obj.BoundingBox
+		r.boxes = append(r.boxes, b)
+		delete(r.shaped, obj)
+	}
+	return nil
+}
+
+func (r *TextRenderer) markShape(rounded bool) {
+	obj := r.current()
+	if obj == nil || (obj.Type != NodeObjectType && obj.Type != ClusterObjectType) {
+		return
+	}
+	b, exists := r.shaped[obj]
+	if !exists {
+		b = &textBox{clear: obj.Type == NodeObjectType}
+		r.shaped[obj] = b
+	}
+	b.rounded = b.rounded || rounded
+}
+
+func (r *TextRenderer) isEdge() bool {
+	obj := r.current()
+	return obj != nil && obj.Type == EdgeObjectType
+}
+
+func (r *TextRenderer) TextSpan(ctx context.Context, job *Job, p *PointFloat, span *TextSpan) error {
+	if err := r.ObjectRenderEngine.TextSpan(ctx, job, p, span); err != nil {
+		return err
+	}
+	r.texts = append(r.texts, &textRun{
+		x:    p.X(),
+		y:    p.Y() + span.Size().Y()/4.0,
+		just: span.Just(),
+		text: span.Text(),
+	})
+	return nil
+}
+
+func (r *TextRenderer) Ellipse(ctx context.Context, job *Job, points []*PointFloat, filled bool) error {
+	if err := r.ObjectRenderEngine.Ellipse(ctx, job, points, filled); err != nil {
+		return err
+	}
+	if len(points) < 2 {
+		return nil
+	}
+	if !r.isEdge() {
+		r.markShape(true)
+		return nil
+	}
+	cx, cy := points[0].X(), points[0].Y()
+	rx, ry := points[1].X()-cx, points[1].Y()-cy
+	var curve [][2]float64
+	for i := 0; i <= 16; i++ {
+		t := 2 * math.Pi * float64(i) / 16
+		curve = append(curve, [2]float64{cx + rx*math.Cos(t), cy + ry*math.Sin(t)})
+	}
+	r.curves = append(r.curves, curve)
+	return nil
+}
+
+func (r *TextRenderer) Polygon(ctx context.Context, job *Job, points []*PointFloat, filled bool) error {
+	if err := r.ObjectRenderEngine.Polygon(ctx, job, points, filled); err != nil {
+		return err
+	}
+	if len(points) == 0 {
+		return nil
+	}
+	if !r.isEdge() {
+		r.markShape(false)
+		return nil
+	}
+	// arrowhead: the vertex farthest from the centroid is the tip.
+	var cx, cy float64
+	for _, p := range points {
+		cx += p.X()
+		cy += p.Y()
+	}
+	cx /= float64(len(points))
+	cy /= float64(len(points))
+	tip := points[0]
+	for _, p := range points[1:] {
+		if math.Hypot(p.X()-cx, p.Y()-cy) > math.Hypot(tip.X()-cx, tip.Y()-cy) {
+			tip = p
+		}
+	}
+	r.arrows = append(r.arrows, &textArrow{x: tip.X(), y: tip.Y(), dx: tip.X() - cx, dy: tip.Y() - cy})
+	return nil
+}
+
+func (r *TextRenderer) BezierCurve(ctx context.Context, job *Job, points []*PointFloat, filled bool) error {
+	if err := r.ObjectRenderEngine.BezierCurve(ctx, job, points, filled); err != nil {
+		return err
+	}
+	if len(points) == 0 || !r.isEdge() {
+		return nil
+	}
+	curve := [][2]float64{{points[0].X(), points[0].Y()}}
+	for i := 1; i+2 < len(points); i += 3 {
+		p0, p1, p2, p3 := points[i-1], points[i], points[i+1], points[i+2]
+		const steps = 16
+		for s := 1; s <= steps; s++ {
+			t := float64(s) / steps
+			u := 1 - t
+			a, b, c, d := u*u*u, 3*u*u*t, 3*u*t*t, t*t*t
+			curve = append(curve, [2]float64{
+				a*p0.X() + b*p1.X() + c*p2.X() + d*p3.X(),
+				a*p0.Y() + b*p1.Y() + c*p2.Y() + d*p3.Y(),
+			})
+		}
+	}
+	r.curves = append(r.curves, curve)
+	return nil
+}
+
+func (r *TextRenderer) Polyline(ctx context.Context, job *Job, points []*PointFloat) error {
+	if err := r.ObjectRenderEngine.Polyline(ctx, job, points); err != nil {
+		return err
+	}
+	if !r.isEdge() {
+		return nil
+	}
+	curve := make([][2]float64, 0, len(points))
+	for _, p := range points {
+		curve = append(curve, [2]float64{p.X(), p.Y()})
+	}
+	r.curves = append(r.curves, curve)
+	return nil
+}
+
+type textGrid struct {
+	ascii  bool
+	minX   float64
+	maxY   float64
+	cols   int
+	rows   int
+	cells  [][]rune
+	dots   [][]uint8
+	slopes [][][2]float64
+}
+
+func (r *TextRenderer) render() string {
+	if r.bounds.Empty() {
+		return ""
+	}
+	g := &textGrid{
+		ascii: r.ascii,
+		minX:  r.bounds.LLX,
+		maxY:  r.bounds.URY,
+		cols:  int(math.Ceil(r.bounds.Width()/textCellWidth)) + 1,
+		rows:  int(math.Ceil(r.bounds.Height()/textCellHeight)) + 1,
+	}
+	g.cells = make([][]rune, g.rows)
+	g.dots = make([][]uint8, g.rows)
+	g.slopes = make([][][2]float64, g.rows)
+	for i := range g.cells {
+		g.cells[i] = make([]rune, g.cols)
+		g.dots[i] = make([]uint8, g.cols)
+		g.slopes[i] = make([][2]float64, g.cols)
+	}
+	for _, curve := range r.curves {
+		g.plotCurve(curve)
+	}
+	g.flushDots()
+	for _, b := range r.boxes {
+		g.drawBox(b)
+	}
+	for _, a := range r.arrows {
+		g.drawArrow(a)
+	}
+	for _, t := range r.texts {
+		g.drawText(t)
+	}
+	var b strings.Builder
+	for _, row := range g.cells {
+		line := strings.TrimRight(strings.Map(func(c rune) rune {
+			if c == 0 {
+				return ' '
+			}
+			return c
+		}, string(row)), " ")
+		b.WriteString(line)
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// position returns the cell and the fractional position inside of the cell.
+func (g *textGrid) position(x, y float64) (int, int, float64, float64) {
+	fx := (x - g.minX) / textCellWidth
+	fy := (g.maxY - y) / textCellHeight
+	col, row := int(math.Floor(fx)), int(math.Floor(fy))
+	return col, row, fx - float64(col), fy - float64(row)
+}
+
+func (g *textGrid) inside(col, row int) bool {
+	return col >= 0 && row >= 0 && col < g.cols && row < g.rows
+}
+
+// braille dot bits indexed by [x][y] inside of a cell.
+var brailleDots = [2][4]uint8{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+func (g *textGrid) plotCurve(curve [][2]float64) {
+	for i := 1; i < len(curve); i++ {
+		x0, y0 := curve[i-1][0], curve[i-1][1]
+		x1, y1 := curve[i][0], curve[i][1]
+		steps := int(math.Ceil(math.Hypot(x1-x0, y1-y0))) + 1
+		for s := 0; s <= steps; s++ {
+			t := float64(s) / float64(steps)
+			col, row, fx, fy := g.position(x0+(x1-x0)*t, y0+(y1-y0)*t)
+			if !g.inside(col, row) {
+				continue
+			}
+			g.dots[row][col] |= brailleDots[min(int(fx*2), 1)][min(int(fy*4), 3)]
+			// y goes down in the grid.
+			g.slopes[row][col] = [2]float64{x1 - x0, y0 - y1}
+		}
+	}
+}
+
+func (g *textGrid) flushDots() {
+	for row := range g.dots {
+		for col, bits := range g.dots[row] {
+			if bits == 0 {
+				continue
+			}
+			if !g.ascii {
+				g.cells[row][col] = rune(0x2800 + int(bits))
+				continue
+			}
+			dx, dy := g.slopes[row][col][0], g.slopes[row][col][1]
+			switch {
+			case math.Abs(dx) > 2*math.Abs(dy):
+				g.cells[row][col] = '-'
+			case math.Abs(dy) > 2*math.Abs(dx):
+				g.cells[row][col] = '|'
+			case dx*dy > 0:
+				g.cells[row][col] = '\\'
+			default:
+				g.cells[row][col] = '/'
+			}
+		}
+	}
+}
+
+func (g *textGrid) set(col, row int, c rune) {
+	if g.inside(col, row) {
+		g.cells[row][col] = c
+	}
+}
+
+func (g *textGrid) drawBox(b *textBox) {
+	if b.box.Empty() {
+		return
+	}
+	c0, r0, _, _ := g.position(b.box.LLX, b.box.URY)
+	c1, r1, _, _ := g.position(b.box.URX, b.box.LLY)
+	if c1-c0 < 1 || r1-r0 < 1 {
+		if g.ascii {
+			g.set(c0, r0, 'o')
+		} else {
+			g.set(c0, r0, '●')
+		}
+		return
+	}
+	h, v, tl, tr, bl, br := '─', '│', '┌', '┐', '└', '┘'
+	if b.rounded {
+		tl, tr, bl, br = '╭', '╮', '╰', '╯'
+	}
+	if g.ascii {
+		h, v, tl, tr, bl, br = '-', '|', '+', '+', '+', '+'
+	}
+	for col := c0 + 1; col < c1; col++ {
+		g.set(col, r0, h)
+		g.set(col, r1, h)
+	}
+	for row := r0 + 1; row < r1; row++ {
+		g.set(c0, row, v)
+		g.set(c1, row, v)
+		for col := c0 + 1; col < c1 && b.clear; col++ {
+			g.set(col, row, ' ')
+		}
+	}
+	g.set(c0, r0, tl)
+	g.set(c1, r0, tr)
+	g.set(c0, r1, bl)
+	g.set(c1, r1, br)
+}
+
+func (g *textGrid) drawArrow(a *textArrow) {
+	col, row, _, _ := g.position(a.x, a.y)
+	var c rune
+	switch {
+	case math.Abs(a.dx) > math.Abs(a.dy) && a.dx > 0:
+		c = '▶'
+	case math.Abs(a.dx) > math.Abs(a.dy):
+		c = '◀'
+	case a.dy > 0:
+		c = '▲'
+	default:
+		c = '▼'
+	}
+	if g.ascii {
+		c = map[rune]rune{'▶': '>', '◀': '<', '▲': '^', '▼': 'v'}[c]
+	}
+	g.set(col, row, c)
+}
+
+func (g *textGrid) drawText(t *textRun) {
+	col, row, _, _ := g.position(t.x, t.y)
+	n := utf8.RuneCountInString(t.text)
+	switch t.just {
+	case 'l':
+	case 'r':
+		col -= n
+	default:
+		col -= n / 2
+	}
+	for _, c := range t.text {
+		g.set(col, row, c)
+		col++
+	}
+}
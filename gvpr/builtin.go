@@ -0,0 +1,276 @@
+package gvpr
+
+import (
+	"fmt"
+	"io"
+	"iter"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+type builtin struct {
+	// minArgs and maxArgs are the range of the number of arguments. maxArgs is -1 if variadic.
+	minArgs, maxArgs int
+	fn               func(in *interp, args []value) (value, error)
+}
+
+var builtins = map[string]*builtin{
+	"print":   {0, -1, builtinPrint},
+	"printf":  {1, -1, builtinPrintf},
+	"sprintf": {1, -1, builtinSprintf},
+	"aget":    {2, 2, builtinAget},
+	"aset":    {3, 3, builtinAset},
+	"node":    {2, 2, builtinNode},
+	"isNode":  {2, 2, builtinIsNode},
+	"edge":    {3, 3, builtinEdge},
+	"isEdge":  {3, 3, builtinIsEdge},
+	"delete":  {2, 2, builtinDelete},
+	"nNodes":  {1, 1, builtinNNodes},
+	"nEdges":  {1, 1, builtinNEdges},
+	"length":  {1, 1, builtinLength},
+	"tolower": {1, 1, builtinToLower},
+	"toupper": {1, 1, builtinToUpper},
+}
+
+func (in *interp) call(x *callExpr) (value, error) {
+	b, exists := builtins[x.name]
+	if !exists {
+		return nil, runtimeError("unknown function %s", x.name)
+	}
+	if len(x.args) < b.minArgs || (b.maxArgs >= 0 && len(x.args) > b.maxArgs) {
+		return nil, runtimeError("wrong number of arguments for %s", x.name)
+	}
+	args := make([]value, 0, len(x.args))
+	for _, arg := range x.args {
+		v, err := in.eval(arg)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	return b.fn(in, args)
+}
+
+func builtinPrint(in *interp, args []value) (value, error) {
+	var b strings.Builder
+	for _, arg := range args {
+		b.WriteString(toString(arg))
+	}
+	b.WriteByte('\n')
+	_, err := io.WriteString(in.out, b.String())
+	return int64(0), err
+}
+
+func builtinPrintf(in *interp, args []value) (value, error) {
+	s, err := format(toString(args[0]), args[1:])
+	if err != nil {
+		return nil, err
+	}
+	_, err = io.WriteString(in.out, s)
+	return int64(0), err
+}
+
+func builtinSprintf(_ *interp, args []value) (value, error) {
+	s, err := format(toString(args[0]), args[1:])
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// format formats the arguments by the format of printf in C.
+func format(f string, args []value) (string, error) {
+	var b strings.Builder
+	for i := 0; i < len(f); i++ {
+		if f[i] != '%' {
+			b.WriteByte(f[i])
+			continue
+		}
+		j := i + 1
+		for j < len(f) && strings.IndexByte("-+ #0123456789.lh", f[j]) >= 0 {
+			j++
+		}
+		if j >= len(f) {
+			return "", runtimeError("invalid format %q", f)
+		}
+		verb := f[j]
+		if verb == '%' {
+			b.WriteByte('%')
+			i = j
+			continue
+		}
+		if len(args) == 0 {
+			return "", runtimeError("missing argument for format %q", f)
+		}
+		spec := strings.NewReplacer("l", "", "h", "").Replace(f[i:j])
+		arg := args[0]
+		args = args[1:]
+		switch verb {
+		case 'd', 'i':
+			fmt.Fprintf(&b, spec+"d", int64(toFloat(toNumber(arg))))
+		case 'o', 'x', 'X':
+			fmt.Fprintf(&b, spec+string(verb), int64(toFloat(toNumber(arg))))
+		case 'c':
+			fmt.Fprintf(&b, spec+"c", rune(toFloat(toNumber(arg))))
+		case 'e', 'E', 'f', 'g', 'G':
+			fmt.Fprintf(&b, spec+string(verb), toFloat(toNumber(arg)))
+		case 's':
+			fmt.Fprintf(&b, spec+"s", toString(arg))
+		default:
+			return "", runtimeError("unsupported format %%%c", verb)
+		}
+		i = j
+	}
+	return b.String(), nil
+}
+
+func builtinAget(in *interp, args []value) (value, error) {
+	v, err := in.attribute(args[0], toString(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	return toString(v), nil
+}
+
+func builtinAset(in *interp, args []value) (value, error) {
+	return int64(0), in.setAttribute(args[0], toString(args[1]), args[2])
+}
+
+// graphArg returns the graph argument. NULL is the current graph.
+func (in *interp) graphArg(v value) (*cgraph.Graph, error) {
+	if v == nil {
+		v = in.graph
+	}
+	g, ok := v.(*cgraph.Graph)
+	if !ok || g == nil {
+		return nil, runtimeError("%s is not a graph", toString(v))
+	}
+	return g, nil
+}
+
+func nodeArg(v value) (*cgraph.Node, error) {
+	n, ok := v.(*cgraph.Node)
+	if !ok || n == nil {
+		return nil, runtimeError("%s is not a node", toString(v))
+	}
+	return n, nil
+}
+
+func builtinNode(in *interp, args []value) (value, error) {
+	g, err := in.graphArg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return g.CreateNodeByName(toString(args[1]))
+}
+
+func builtinIsNode(in *interp, args []value) (value, error) {
+	g, err := in.graphArg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	n, err := g.NodeByName(toString(args[1]))
+	if err != nil || n == nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// endpoints returns the current graph and the tail and head of the edge to create or find.
+func (in *interp) endpoints(args []value) (*cgraph.Graph, *cgraph.Node, *cgraph.Node, error) {
+	g, err := in.graphArg(nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	tail, err := nodeArg(args[0])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	head, err := nodeArg(args[1])
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return g, tail, head, nil
+}
+
+func builtinEdge(in *interp, args []value) (value, error) {
+	g, tail, head, err := in.endpoints(args)
+	if err != nil {
+		return nil, err
+	}
+	return g.CreateEdgeByName(toString(args[2]), tail, head)
+}
+
+func builtinIsEdge(in *interp, args []value) (value, error) {
+	g, tail, head, err := in.endpoints(args)
+	if err != nil {
+		return nil, err
+	}
+	e, err := g.EdgeByName(toString(args[2]), tail, head)
+	if err != nil || e == nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func builtinDelete(in *interp, args []value) (value, error) {
+	g, err := in.graphArg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	switch obj := args[1].(type) {
+	case *cgraph.Node:
+		// the edges of the node are deleted together.
+		for _, edges := range []iter.Seq2[*cgraph.Edge, error]{g.OutEdges(obj), g.InEdges(obj)} {
+			for e, err := range edges {
+				if err != nil {
+					return nil, err
+				}
+				in.markDeleted(e)
+			}
+		}
+		in.markDeleted(obj)
+		if _, err := g.DeleteNode(obj); err != nil {
+			return nil, err
+		}
+	case *cgraph.Edge:
+		in.markDeleted(obj)
+		if _, err := g.DeleteEdge(obj); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, runtimeError("cannot delete %s", toString(obj))
+	}
+	return int64(0), nil
+}
+
+func builtinNNodes(in *interp, args []value) (value, error) {
+	g, err := in.graphArg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	n, err := g.NodeNum()
+	return int64(n), err
+}
+
+func builtinNEdges(in *interp, args []value) (value, error) {
+	g, err := in.graphArg(args[0])
+	if err != nil {
+		return nil, err
+	}
+	n, err := g.EdgeNum()
+	return int64(n), err
+}
+
+func builtinLength(_ *interp, args []value) (value, error) {
+	return int64(len(toString(args[0]))), nil
+}
+
+func builtinToLower(_ *interp, args []value) (value, error) {
+	return strings.ToLower(toString(args[0])), nil
+}
+
+func builtinToUpper(_ *interp, args []value) (value, error) {
+	return strings.ToUpper(toString(args[0])), nil
+}
@@ -0,0 +1,707 @@
+package gvpr
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// value is nil, int64, float64, string, *cgraph.Graph, *cgraph.Node or *cgraph.Edge.
+type value any
+
+type variable struct {
+	typ string
+	v   value
+}
+
+type objectKey struct {
+	tag cgraph.ObjectTag
+	id  cgraph.ID
+}
+
+type interp struct {
+	out     io.Writer
+	globals map[string]*variable
+	locals  map[string]*variable
+	// global reports whether the declarations are global. It is true in BEGIN clauses.
+	global bool
+	graph  *cgraph.Graph
+	// cur is the current object $.
+	cur     value
+	deleted map[objectKey]bool
+}
+
+func runtimeError(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrRuntime, fmt.Sprintf(format, args...))
+}
+
+func (in *interp) runAction(b *blockStmt) error {
+	in.locals = map[string]*variable{}
+	return in.exec(b)
+}
+
+// runClauses runs the N or E clauses for the object.
+func (in *interp) runClauses(clauses []*clause, obj value) error {
+	for _, c := range clauses {
+		if in.isDeleted(obj) {
+			return nil
+		}
+		in.cur = obj
+		in.locals = map[string]*variable{}
+		if c.pred != nil {
+			v, err := in.eval(c.pred)
+			if err != nil {
+				return err
+			}
+			if !toBool(v) {
+				continue
+			}
+		}
+		if c.body != nil {
+			if err := in.exec(c.body); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (in *interp) exec(s stmt) error {
+	switch s := s.(type) {
+	case *blockStmt:
+		for _, s := range s.stmts {
+			if err := in.exec(s); err != nil {
+				return err
+			}
+		}
+	case *exprStmt:
+		_, err := in.eval(s.x)
+		return err
+	case *ifStmt:
+		cond, err := in.eval(s.cond)
+		if err != nil {
+			return err
+		}
+		if toBool(cond) {
+			return in.exec(s.then)
+		}
+		if s.elseStmt != nil {
+			return in.exec(s.elseStmt)
+		}
+	case *whileStmt:
+		for {
+			cond, err := in.eval(s.cond)
+			if err != nil {
+				return err
+			}
+			if !toBool(cond) {
+				return nil
+			}
+			if err := in.exec(s.body); err != nil {
+				return err
+			}
+		}
+	case *forStmt:
+		if s.init != nil {
+			if _, err := in.eval(s.init); err != nil {
+				return err
+			}
+		}
+		for {
+			if s.cond != nil {
+				cond, err := in.eval(s.cond)
+				if err != nil {
+					return err
+				}
+				if !toBool(cond) {
+					return nil
+				}
+			}
+			if err := in.exec(s.body); err != nil {
+				return err
+			}
+			if s.post != nil {
+				if _, err := in.eval(s.post); err != nil {
+					return err
+				}
+			}
+		}
+	case *declStmt:
+		vars := in.locals
+		if in.global {
+			vars = in.globals
+		}
+		for i, name := range s.names {
+			v := &variable{typ: s.typ, v: zeroValue(s.typ)}
+			if s.inits[i] != nil {
+				init, err := in.eval(s.inits[i])
+				if err != nil {
+					return err
+				}
+				if v.v, err = convert(s.typ, init); err != nil {
+					return err
+				}
+			}
+			vars[name] = v
+		}
+	}
+	return nil
+}
+
+func (in *interp) lookup(name string) *variable {
+	if v, exists := in.locals[name]; exists {
+		return v
+	}
+	return in.globals[name]
+}
+
+func (in *interp) eval(x expr) (value, error) {
+	switch x := x.(type) {
+	case *literal:
+		return x.v, nil
+	case *identExpr:
+		switch x.name {
+		case "$":
+			return in.cur, nil
+		case "$G":
+			if in.graph == nil {
+				return nil, nil
+			}
+			return in.graph, nil
+		}
+		if v := in.lookup(x.name); v != nil {
+			return v.v, nil
+		}
+		if strings.HasPrefix(x.name, "$") {
+			return nil, runtimeError("unsupported variable %s", x.name)
+		}
+		if in.cur == nil {
+			return nil, runtimeError("undefined variable %s", x.name)
+		}
+		return in.attribute(in.cur, x.name)
+	case *memberExpr:
+		obj, err := in.eval(x.x)
+		if err != nil {
+			return nil, err
+		}
+		return in.attribute(obj, x.name)
+	case *unaryExpr:
+		v, err := in.eval(x.x)
+		if err != nil {
+			return nil, err
+		}
+		switch x.op {
+		case "!":
+			return boolValue(!toBool(v)), nil
+		case "-":
+			return arith("-", int64(0), v)
+		}
+		return toNumber(v), nil
+	case *binaryExpr:
+		return in.evalBinary(x)
+	case *condExpr:
+		cond, err := in.eval(x.cond)
+		if err != nil {
+			return nil, err
+		}
+		if toBool(cond) {
+			return in.eval(x.x)
+		}
+		return in.eval(x.y)
+	case *assignExpr:
+		return in.evalAssign(x)
+	case *callExpr:
+		return in.call(x)
+	}
+	return nil, runtimeError("unknown expression %T", x)
+}
+
+func (in *interp) evalBinary(x *binaryExpr) (value, error) {
+	l, err := in.eval(x.x)
+	if err != nil {
+		return nil, err
+	}
+	switch x.op {
+	case "&&", "||":
+		if toBool(l) == (x.op == "||") {
+			return boolValue(toBool(l)), nil
+		}
+		r, err := in.eval(x.y)
+		if err != nil {
+			return nil, err
+		}
+		return boolValue(toBool(r)), nil
+	}
+	r, err := in.eval(x.y)
+	if err != nil {
+		return nil, err
+	}
+	switch x.op {
+	case "==", "!=":
+		var eq bool
+		if lit, ok := x.y.(*literal); ok && lit.pattern {
+			eq = matchPattern(lit.v.(string), toString(l))
+		} else {
+			eq = compare(l, r) == 0
+		}
+		return boolValue(eq == (x.op == "==")), nil
+	case "<":
+		return boolValue(compare(l, r) < 0), nil
+	case "<=":
+		return boolValue(compare(l, r) <= 0), nil
+	case ">":
+		return boolValue(compare(l, r) > 0), nil
+	case ">=":
+		return boolValue(compare(l, r) >= 0), nil
+	}
+	return arith(x.op, l, r)
+}
+
+func (in *interp) evalAssign(x *assignExpr) (value, error) {
+	v, err := in.eval(x.rhs)
+	if err != nil {
+		return nil, err
+	}
+	var cur value
+	if x.op != "=" {
+		if cur, err = in.eval(x.lhs); err != nil {
+			return nil, err
+		}
+		if v, err = arith(x.op[:1], cur, v); err != nil {
+			return nil, err
+		}
+	}
+	switch lhs := x.lhs.(type) {
+	case *identExpr:
+		if variable := in.lookup(lhs.name); variable != nil {
+			if variable.v, err = convert(variable.typ, v); err != nil {
+				return nil, err
+			}
+			v = variable.v
+			break
+		}
+		if in.cur == nil {
+			return nil, runtimeError("undefined variable %s", lhs.name)
+		}
+		if err := in.setAttribute(in.cur, lhs.name, v); err != nil {
+			return nil, err
+		}
+	case *memberExpr:
+		obj, err := in.eval(lhs.x)
+		if err != nil {
+			return nil, err
+		}
+		if err := in.setAttribute(obj, lhs.name, v); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, runtimeError("cannot assign to the expression")
+	}
+	if x.postfix {
+		return cur, nil
+	}
+	return v, nil
+}
+
+// attribute returns the pseudo attribute or the attribute of the object.
+func (in *interp) attribute(obj value, name string) (value, error) {
+	switch obj := obj.(type) {
+	case *cgraph.Graph:
+		switch name {
+		case "name":
+			return obj.Name()
+		case "directed":
+			directed, err := obj.IsDirected()
+			return boolValue(directed), err
+		case "strict":
+			strict, err := obj.IsStrict()
+			return boolValue(strict), err
+		case "n_nodes":
+			n, err := obj.NodeNum()
+			return int64(n), err
+		case "n_edges":
+			n, err := obj.EdgeNum()
+			return int64(n), err
+		}
+		return obj.GetStr(name), nil
+	case *cgraph.Node:
+		var (
+			n   int
+			err error
+		)
+		switch name {
+		case "name":
+			return obj.Name()
+		case "indegree", "outdegree", "degree":
+			if in.graph == nil {
+				return nil, runtimeError("%s of a node requires the current graph", name)
+			}
+		}
+		switch name {
+		case "indegree":
+			n, err = in.graph.Indegree(obj)
+		case "outdegree":
+			n, err = in.graph.Outdegree(obj)
+		case "degree":
+			n, err = in.graph.TotalDegree(obj)
+		default:
+			return obj.GetStr(name), nil
+		}
+		return int64(n), err
+	case *cgraph.Edge:
+		switch name {
+		case "name":
+			return obj.Name()
+		case "tail":
+			return obj.Tail()
+		case "head":
+			return obj.Head()
+		}
+		return obj.GetStr(name), nil
+	case nil:
+		return nil, runtimeError("reference to the attribute %s of NULL", name)
+	}
+	return nil, runtimeError("%s is not an object", toString(obj))
+}
+
+func (in *interp) setAttribute(obj value, name string, v value) error {
+	switch name {
+	case "name", "indegree", "outdegree", "degree", "tail", "head", "directed", "strict", "n_nodes", "n_edges":
+		return runtimeError("cannot assign to the pseudo attribute %s", name)
+	}
+	s := toString(v)
+	switch obj := obj.(type) {
+	case *cgraph.Graph:
+		return obj.SafeSet(name, s, "")
+	case *cgraph.Node:
+		return obj.SafeSet(name, s, "")
+	case *cgraph.Edge:
+		return obj.SafeSet(name, s, "")
+	case nil:
+		return runtimeError("assignment to the attribute %s of NULL", name)
+	}
+	return runtimeError("%s is not an object", toString(obj))
+}
+
+func (in *interp) isDeleted(obj value) bool {
+	key, ok := keyOf(obj)
+	return ok && in.deleted[key]
+}
+
+func keyOf(obj value) (objectKey, bool) {
+	switch obj := obj.(type) {
+	case *cgraph.Graph:
+		return objectKey{tag: cgraph.GRAPH, id: obj.Base().Tag().ID()}, true
+	case *cgraph.Node:
+		return objectKey{tag: cgraph.NODE, id: obj.Base().Tag().ID()}, true
+	case *cgraph.Edge:
+		return objectKey{tag: cgraph.EDGE, id: obj.Base().Tag().ID()}, true
+	}
+	return objectKey{}, false
+}
+
+func (in *interp) markDeleted(obj value) {
+	if key, ok := keyOf(obj); ok {
+		if in.deleted == nil {
+			in.deleted = map[objectKey]bool{}
+		}
+		in.deleted[key] = true
+	}
+}
+
+func zeroValue(typ string) value {
+	switch typ {
+	case "int", "long", "char":
+		return int64(0)
+	case "double", "float":
+		return float64(0)
+	case "string":
+		return ""
+	}
+	return nil
+}
+
+// convert converts the value to the type of a variable.
+func convert(typ string, v value) (value, error) {
+	switch typ {
+	case "int", "long", "char":
+		switch n := toNumber(v).(type) {
+		case float64:
+			return int64(n), nil
+		default:
+			return n, nil
+		}
+	case "double", "float":
+		switch n := toNumber(v).(type) {
+		case int64:
+			return float64(n), nil
+		default:
+			return n, nil
+		}
+	case "string":
+		return toString(v), nil
+	case "node_t":
+		if _, ok := v.(*cgraph.Node); !ok && v != nil {
+			return nil, runtimeError("%s is not a node", toString(v))
+		}
+	case "edge_t":
+		if _, ok := v.(*cgraph.Edge); !ok && v != nil {
+			return nil, runtimeError("%s is not an edge", toString(v))
+		}
+	case "graph_t":
+		if _, ok := v.(*cgraph.Graph); !ok && v != nil {
+			return nil, runtimeError("%s is not a graph", toString(v))
+		}
+	}
+	return v, nil
+}
+
+func boolValue(b bool) value {
+	if b {
+		return int64(1)
+	}
+	return int64(0)
+}
+
+func toBool(v value) bool {
+	switch v := v.(type) {
+	case nil:
+		return false
+	case int64:
+		return v != 0
+	case float64:
+		return v != 0
+	case string:
+		return v != ""
+	}
+	return true
+}
+
+// toNumber converts the value to int64 or float64. Strings which are not numbers are 0.
+func toNumber(v value) value {
+	switch v := v.(type) {
+	case int64, float64:
+		return v
+	case string:
+		s := strings.TrimSpace(v)
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			return i
+		}
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return f
+		}
+	}
+	return int64(0)
+}
+
+func toString(v value) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case *cgraph.Graph:
+		name, _ := v.Name()
+		return name
+	case *cgraph.Node:
+		name, _ := v.Name()
+		return name
+	case *cgraph.Edge:
+		tail, _ := v.Tail()
+		head, _ := v.Head()
+		var t, h string
+		if tail != nil {
+			t, _ = tail.Name()
+		}
+		if head != nil {
+			h, _ = head.Name()
+		}
+		return t + " -> " + h
+	}
+	return fmt.Sprint(v)
+}
+
+func isNumber(v value) bool {
+	switch v.(type) {
+	case int64, float64:
+		return true
+	}
+	return false
+}
+
+// compare compares the values as numbers if either of them is a number, otherwise as strings or objects.
+func compare(l, r value) int {
+	if isNumber(l) || isNumber(r) {
+		lf, rf := toFloat(toNumber(l)), toFloat(toNumber(r))
+		switch {
+		case lf < rf:
+			return -1
+		case lf > rf:
+			return 1
+		}
+		return 0
+	}
+	ls, lok := l.(string)
+	rs, rok := r.(string)
+	if lok && rok {
+		return strings.Compare(ls, rs)
+	}
+	if l == nil || r == nil {
+		return boolCompare(l != nil, r != nil)
+	}
+	lk, lok := keyOf(l)
+	rk, rok := keyOf(r)
+	if lok && rok && lk == rk {
+		return 0
+	}
+	if c := strings.Compare(toString(l), toString(r)); c != 0 {
+		return c
+	}
+	// different objects are not equal even if they are written in the same way.
+	return -1
+}
+
+func boolCompare(l, r bool) int {
+	switch {
+	case l == r:
+		return 0
+	case l:
+		return 1
+	}
+	return -1
+}
+
+func toFloat(v value) float64 {
+	switch v := v.(type) {
+	case int64:
+		return float64(v)
+	case float64:
+		return v
+	}
+	return 0
+}
+
+// arith applies the arithmetic operator. Strings are converted to numbers,
+// and the ones which are not numbers are type errors instead of 0 because gvpr has no string concatenation by '+'.
+func arith(op string, l, r value) (value, error) {
+	for _, v := range []value{l, r} {
+		s, ok := v.(string)
+		if !ok || strings.TrimSpace(s) == "" {
+			continue
+		}
+		if _, err := strconv.ParseFloat(strings.TrimSpace(s), 64); err != nil {
+			return nil, runtimeError("cannot apply %s to the string %q", op, s)
+		}
+	}
+	ln, rn := toNumber(l), toNumber(r)
+	li, lok := ln.(int64)
+	ri, rok := rn.(int64)
+	if lok && rok {
+		switch op {
+		case "+":
+			return li + ri, nil
+		case "-":
+			return li - ri, nil
+		case "*":
+			return li * ri, nil
+		case "/", "%":
+			if ri == 0 {
+				return nil, runtimeError("division by zero")
+			}
+			if op == "/" {
+				return li / ri, nil
+			}
+			return li % ri, nil
+		}
+	}
+	lf, rf := toFloat(ln), toFloat(rn)
+	switch op {
+	case "+":
+		return lf + rf, nil
+	case "-":
+		return lf - rf, nil
+	case "*":
+		return lf * rf, nil
+	case "/":
+		if rf == 0 {
+			return nil, runtimeError("division by zero")
+		}
+		return lf / rf, nil
+	case "%":
+		if rf == 0 {
+			return nil, runtimeError("division by zero")
+		}
+		return math.Mod(lf, rf), nil
+	}
+	return nil, runtimeError("unknown operator %s", op)
+}
+
+// matchPattern reports whether s matches the pattern of the shell where '*' matches any string,
+// '?' matches any character and '[...]' matches one of the characters.
+func matchPattern(pattern, s string) bool {
+	for len(pattern) > 0 {
+		switch pattern[0] {
+		case '*':
+			for i := len(s); i >= 0; i-- {
+				if matchPattern(pattern[1:], s[i:]) {
+					return true
+				}
+			}
+			return false
+		case '?':
+			if s == "" {
+				return false
+			}
+			pattern, s = pattern[1:], s[1:]
+		case '[':
+			end := strings.IndexByte(pattern[1:], ']')
+			if end < 0 || s == "" {
+				return pattern == s
+			}
+			if !matchClass(pattern[1:end+1], s[0]) {
+				return false
+			}
+			pattern, s = pattern[end+2:], s[1:]
+		case '\\':
+			if len(pattern) > 1 {
+				pattern = pattern[1:]
+			}
+			fallthrough
+		default:
+			if s == "" || s[0] != pattern[0] {
+				return false
+			}
+			pattern, s = pattern[1:], s[1:]
+		}
+	}
+	return s == ""
+}
+
+func matchClass(class string, c byte) bool {
+	negate := len(class) > 0 && (class[0] == '!' || class[0] == '^')
+	if negate {
+		class = class[1:]
+	}
+	matched := false
+	for i := 0; i < len(class); i++ {
+		if i+2 < len(class) && class[i+1] == '-' {
+			if class[i] <= c && c <= class[i+2] {
+				matched = true
+			}
+			i += 2
+			continue
+		}
+		if class[i] == c {
+			matched = true
+		}
+	}
+	return matched != negate
+}
@@ -0,0 +1,139 @@
+// Package gvpr runs programs written in a subset of the gvpr language of Graphviz against graphs.
+// The language is described in https://graphviz.org/pdf/gvpr.1.pdf .
+//
+// The following features are supported.
+//
+//   - The BEGIN, BEG_G, N, E, END_G and END clauses. N and E clauses take an optional predicate in brackets
+//     and an optional action. For each graph, N clauses are applied to all nodes before E clauses are applied to all edges.
+//   - The statements of expressions, blocks, if, while, for and declarations of int, double, string, node_t, edge_t, graph_t and obj_t variables.
+//   - The operators of C except the bitwise ones. == and != match the left operand with the pattern
+//     if the right operand is a string literal containing '*', '?' or '['.
+//   - $ as the current object and $G as the current graph. An attribute is referred as obj.attr,
+//     or as attr for the current object. Assigning a value to an attribute declares it if needed.
+//   - The pseudo attributes name, indegree, outdegree and degree of nodes, name, tail and head of edges,
+//     and name, directed, strict, n_nodes and n_edges of graphs.
+//   - The functions print, printf, sprintf, aget, aset, node, edge, isNode, isEdge, delete,
+//     nNodes, nEdges, length, tolower and toupper.
+//
+// The graphs are modified in place. Unlike the gvpr tool, they are not written to the output.
+package gvpr
+
+import (
+	"bytes"
+	"errors"
+	"io"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+var (
+	ErrSyntax  = errors.New("gvpr: syntax error")
+	ErrRuntime = errors.New("gvpr: runtime error")
+)
+
+// Program is a compiled gvpr program.
+type Program struct {
+	begin, begG, endG, end []*blockStmt
+	nodes, edges           []*clause
+}
+
+// clause is an N or E clause.
+type clause struct {
+	// pred is the predicate. It is nil if omitted.
+	pred expr
+	// body is the action. It is nil if omitted.
+	body *blockStmt
+}
+
+// Compile parses the program.
+func Compile(program string) (*Program, error) {
+	tokens, err := tokenize(program)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens}
+	return p.parseProgram()
+}
+
+// Run compiles and runs the program against the graphs, and returns the text written by print and printf.
+func Run(program string, graphs ...*cgraph.Graph) (string, error) {
+	p, err := Compile(program)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := p.Run(&buf, graphs...); err != nil {
+		return buf.String(), err
+	}
+	return buf.String(), nil
+}
+
+// Run runs the program against the graphs in order and writes the text of print and printf to w.
+// BEGIN and END clauses are run once even if no graph is given.
+func (p *Program) Run(w io.Writer, graphs ...*cgraph.Graph) error {
+	// the variables declared in BEGIN clauses are visible in all clauses.
+	in := &interp{out: w, globals: map[string]*variable{}, global: true}
+	for _, b := range p.begin {
+		if err := in.runAction(b); err != nil {
+			return err
+		}
+	}
+	in.global = false
+	for _, g := range graphs {
+		if err := p.runGraph(in, g); err != nil {
+			return err
+		}
+	}
+	in.graph, in.cur = nil, nil
+	for _, b := range p.end {
+		if err := in.runAction(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Program) runGraph(in *interp, g *cgraph.Graph) error {
+	in.graph, in.cur = g, g
+	for _, b := range p.begG {
+		if err := in.runAction(b); err != nil {
+			return err
+		}
+	}
+	if len(p.nodes) > 0 {
+		// nodes are collected first because actions may delete or create nodes.
+		var nodes []*cgraph.Node
+		for n, err := range g.Nodes() {
+			if err != nil {
+				return err
+			}
+			nodes = append(nodes, n)
+		}
+		for _, n := range nodes {
+			if err := in.runClauses(p.nodes, n); err != nil {
+				return err
+			}
+		}
+	}
+	if len(p.edges) > 0 {
+		var edges []*cgraph.Edge
+		for e, err := range g.Edges() {
+			if err != nil {
+				return err
+			}
+			edges = append(edges, e)
+		}
+		for _, e := range edges {
+			if err := in.runClauses(p.edges, e); err != nil {
+				return err
+			}
+		}
+	}
+	in.cur = g
+	for _, b := range p.endG {
+		if err := in.runAction(b); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package gvpr
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenNumber
+	tokenString
+	tokenOperator
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+	col  int
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "end of program"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// operators are sorted by length so that the longest operator is matched first.
+var operators = []string{
+	"==", "!=", "<=", ">=", "&&", "||", "++", "--", "+=", "-=", "*=", "/=",
+	"<", ">", "=", "+", "-", "*", "/", "%", "!", "?", ":", ".", ",", ";", "(", ")", "{", "}", "[", "]",
+}
+
+type lexer struct {
+	src  string
+	pos  int
+	line int
+	col  int
+}
+
+func tokenize(src string) ([]token, error) {
+	l := &lexer{src: src, line: 1, col: 1}
+	var tokens []token
+	for {
+		t, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+		if t.kind == tokenEOF {
+			return tokens, nil
+		}
+	}
+}
+
+func (l *lexer) errorf(format string, args ...any) error {
+	return fmt.Errorf("%w at %d:%d: %s", ErrSyntax, l.line, l.col, fmt.Sprintf(format, args...))
+}
+
+func (l *lexer) advance(n int) {
+	for _, c := range l.src[l.pos : l.pos+n] {
+		if c == '\n' {
+			l.line++
+			l.col = 1
+		} else {
+			l.col++
+		}
+	}
+	l.pos += n
+}
+
+// skipSpaces skips spaces, comments and lines of the C preprocessor.
+func (l *lexer) skipSpaces() error {
+	for l.pos < len(l.src) {
+		rest := l.src[l.pos:]
+		switch {
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\r' || rest[0] == '\n':
+			l.advance(1)
+		case strings.HasPrefix(rest, "//") || (rest[0] == '#' && (l.col == 1)):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			l.advance(end)
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				return l.errorf("unterminated comment")
+			}
+			l.advance(end + 4)
+		default:
+			return nil
+		}
+	}
+	return nil
+}
+
+func (l *lexer) next() (token, error) {
+	if err := l.skipSpaces(); err != nil {
+		return token{}, err
+	}
+	t := token{line: l.line, col: l.col}
+	if l.pos >= len(l.src) {
+		t.kind = tokenEOF
+		return t, nil
+	}
+	rest := l.src[l.pos:]
+	c := rest[0]
+	switch {
+	case c == '$':
+		// $, $G and the other special variables.
+		n := 1
+		for n < len(rest) && isIdentChar(rest[n]) {
+			n++
+		}
+		t.kind, t.text = tokenIdent, rest[:n]
+		l.advance(n)
+	case isIdentStart(c):
+		n := 1
+		for n < len(rest) && isIdentChar(rest[n]) {
+			n++
+		}
+		t.kind, t.text = tokenIdent, rest[:n]
+		l.advance(n)
+	case isDigit(c) || (c == '.' && len(rest) > 1 && isDigit(rest[1])):
+		n := 0
+		for n < len(rest) && (isDigit(rest[n]) || rest[n] == '.') {
+			n++
+		}
+		if n < len(rest) && (rest[n] == 'e' || rest[n] == 'E') {
+			n++
+			if n < len(rest) && (rest[n] == '+' || rest[n] == '-') {
+				n++
+			}
+			for n < len(rest) && isDigit(rest[n]) {
+				n++
+			}
+		}
+		t.kind, t.text = tokenNumber, rest[:n]
+		l.advance(n)
+	case c == '"' || c == '\'':
+		s, n, err := l.scanString(rest)
+		if err != nil {
+			return token{}, err
+		}
+		t.kind, t.text = tokenString, s
+		l.advance(n)
+	default:
+		for _, op := range operators {
+			if strings.HasPrefix(rest, op) {
+				t.kind, t.text = tokenOperator, op
+				l.advance(len(op))
+				return t, nil
+			}
+		}
+		return token{}, l.errorf("unexpected character %q", c)
+	}
+	return t, nil
+}
+
+// scanString returns the unquoted string and the length of the quoted string.
+func (l *lexer) scanString(src string) (string, int, error) {
+	quote := src[0]
+	var b strings.Builder
+	for i := 1; i < len(src); i++ {
+		c := src[i]
+		switch c {
+		case quote:
+			return b.String(), i + 1, nil
+		case '\n':
+			return "", 0, l.errorf("newline in string")
+		case '\\':
+			i++
+			if i >= len(src) {
+				break
+			}
+			switch src[i] {
+			case 'n':
+				b.WriteByte('\n')
+			case 't':
+				b.WriteByte('\t')
+			case 'r':
+				b.WriteByte('\r')
+			case '\\', '"', '\'':
+				b.WriteByte(src[i])
+			default:
+				// escape sequences of Graphviz such as "\N" and "\l" are kept.
+				b.WriteByte('\\')
+				b.WriteByte(src[i])
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, l.errorf("unterminated string")
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c)
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
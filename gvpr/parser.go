@@ -0,0 +1,525 @@
+package gvpr
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type (
+	expr interface{}
+	stmt interface{}
+)
+
+type (
+	literal struct {
+		v value
+		// pattern reports whether the literal is a string which can be matched as a pattern by == and !=.
+		pattern bool
+	}
+	identExpr struct {
+		name string
+	}
+	memberExpr struct {
+		x    expr
+		name string
+	}
+	unaryExpr struct {
+		op string
+		x  expr
+	}
+	binaryExpr struct {
+		op   string
+		x, y expr
+	}
+	condExpr struct {
+		cond, x, y expr
+	}
+	assignExpr struct {
+		// op is "=", "+=", "-=", "*=" or "/=". ++ and -- are converted into += 1 and -= 1.
+		op  string
+		lhs expr
+		rhs expr
+		// postfix is true for x++ and x--, which evaluate to the value before the update.
+		postfix bool
+	}
+	callExpr struct {
+		name string
+		args []expr
+	}
+)
+
+type (
+	exprStmt struct {
+		x expr
+	}
+	blockStmt struct {
+		stmts []stmt
+	}
+	ifStmt struct {
+		cond           expr
+		then, elseStmt stmt
+	}
+	whileStmt struct {
+		cond expr
+		body stmt
+	}
+	forStmt struct {
+		init, cond, post expr
+		body             stmt
+	}
+	declStmt struct {
+		typ   string
+		names []string
+		inits []expr
+	}
+)
+
+// types are the types of the variable declarations.
+var types = map[string]bool{
+	"int": true, "long": true, "double": true, "float": true, "char": true, "string": true,
+	"node_t": true, "edge_t": true, "graph_t": true, "obj_t": true,
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) is(text string) bool {
+	t := p.peek()
+	return (t.kind == tokenOperator || t.kind == tokenIdent) && t.text == text
+}
+
+func (p *parser) errorf(t token, format string, args ...any) error {
+	return fmt.Errorf("%w at %d:%d: %s", ErrSyntax, t.line, t.col, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(text string) error {
+	if t := p.next(); !(t.kind == tokenOperator || t.kind == tokenIdent) || t.text != text {
+		return p.errorf(t, "expected %q but got %s", text, t)
+	}
+	return nil
+}
+
+func (p *parser) parseProgram() (*Program, error) {
+	prog := &Program{}
+	for p.peek().kind != tokenEOF {
+		t := p.next()
+		if t.kind != tokenIdent {
+			return nil, p.errorf(t, "expected a clause but got %s", t)
+		}
+		switch t.text {
+		case "BEGIN", "BEG_G", "END_G", "END":
+			body, err := p.parseBlock()
+			if err != nil {
+				return nil, err
+			}
+			switch t.text {
+			case "BEGIN":
+				prog.begin = append(prog.begin, body)
+			case "BEG_G":
+				prog.begG = append(prog.begG, body)
+			case "END_G":
+				prog.endG = append(prog.endG, body)
+			case "END":
+				prog.end = append(prog.end, body)
+			}
+		case "N", "E":
+			c := &clause{}
+			if p.is("[") {
+				p.next()
+				pred, err := p.parseExpr()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expect("]"); err != nil {
+					return nil, err
+				}
+				c.pred = pred
+			}
+			if p.is("{") {
+				body, err := p.parseBlock()
+				if err != nil {
+					return nil, err
+				}
+				c.body = body
+			}
+			if t.text == "N" {
+				prog.nodes = append(prog.nodes, c)
+			} else {
+				prog.edges = append(prog.edges, c)
+			}
+		default:
+			return nil, p.errorf(t, "unknown clause %s", t)
+		}
+	}
+	return prog, nil
+}
+
+func (p *parser) parseBlock() (*blockStmt, error) {
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	b := &blockStmt{}
+	for !p.is("}") {
+		if p.peek().kind == tokenEOF {
+			return nil, p.errorf(p.peek(), "missing '}'")
+		}
+		s, err := p.parseStmt()
+		if err != nil {
+			return nil, err
+		}
+		if s != nil {
+			b.stmts = append(b.stmts, s)
+		}
+	}
+	p.next()
+	return b, nil
+}
+
+func (p *parser) parseStmt() (stmt, error) {
+	t := p.peek()
+	switch {
+	case p.is("{"):
+		return p.parseBlock()
+	case p.is(";"):
+		p.next()
+		return nil, nil
+	case t.kind == tokenIdent && t.text == "if":
+		p.next()
+		cond, err := p.parseParenExpr()
+		if err != nil {
+			return nil, err
+		}
+		s := &ifStmt{cond: cond}
+		if s.then, err = p.parseStmt(); err != nil {
+			return nil, err
+		}
+		if p.is("else") {
+			p.next()
+			if s.elseStmt, err = p.parseStmt(); err != nil {
+				return nil, err
+			}
+		}
+		return s, nil
+	case t.kind == tokenIdent && t.text == "while":
+		p.next()
+		cond, err := p.parseParenExpr()
+		if err != nil {
+			return nil, err
+		}
+		body, err := p.parseStmt()
+		if err != nil {
+			return nil, err
+		}
+		return &whileStmt{cond: cond, body: body}, nil
+	case t.kind == tokenIdent && t.text == "for":
+		return p.parseFor()
+	case t.kind == tokenIdent && types[t.text]:
+		return p.parseDecl()
+	}
+	x, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.endStmt(); err != nil {
+		return nil, err
+	}
+	return &exprStmt{x: x}, nil
+}
+
+// endStmt reads the ';' at the end of a statement, which can be omitted before '}' or at the end of the input like gvpr.
+func (p *parser) endStmt() error {
+	if p.is("}") || p.peek().kind == tokenEOF {
+		return nil
+	}
+	return p.expect(";")
+}
+
+func (p *parser) parseFor() (stmt, error) {
+	p.next()
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	s := &forStmt{}
+	var err error
+	for i, end := range []string{";", ";", ")"} {
+		var x expr
+		if !p.is(end) {
+			if x, err = p.parseExpr(); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.expect(end); err != nil {
+			return nil, err
+		}
+		switch i {
+		case 0:
+			s.init = x
+		case 1:
+			s.cond = x
+		case 2:
+			s.post = x
+		}
+	}
+	if s.body, err = p.parseStmt(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (p *parser) parseDecl() (stmt, error) {
+	s := &declStmt{typ: p.next().text}
+	for {
+		t := p.next()
+		if t.kind != tokenIdent {
+			return nil, p.errorf(t, "expected a variable name but got %s", t)
+		}
+		var init expr
+		if p.is("=") {
+			p.next()
+			x, err := p.parseAssign()
+			if err != nil {
+				return nil, err
+			}
+			init = x
+		}
+		s.names = append(s.names, t.text)
+		s.inits = append(s.inits, init)
+		if !p.is(",") {
+			break
+		}
+		p.next()
+	}
+	if err := p.endStmt(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (p *parser) parseParenExpr() (expr, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	x, err := p.parseExpr()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(")"); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+func (p *parser) parseExpr() (expr, error) {
+	return p.parseAssign()
+}
+
+func (p *parser) parseAssign() (expr, error) {
+	lhs, err := p.parseCond()
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	switch t.text {
+	case "=", "+=", "-=", "*=", "/=":
+		if t.kind != tokenOperator {
+			return lhs, nil
+		}
+		if !isAssignable(lhs) {
+			return nil, p.errorf(t, "cannot assign to the expression")
+		}
+		p.next()
+		rhs, err := p.parseAssign()
+		if err != nil {
+			return nil, err
+		}
+		return &assignExpr{op: t.text, lhs: lhs, rhs: rhs}, nil
+	}
+	return lhs, nil
+}
+
+func (p *parser) parseCond() (expr, error) {
+	cond, err := p.parseBinary(0)
+	if err != nil {
+		return nil, err
+	}
+	if !p.is("?") {
+		return cond, nil
+	}
+	p.next()
+	x, err := p.parseAssign()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	y, err := p.parseCond()
+	if err != nil {
+		return nil, err
+	}
+	return &condExpr{cond: cond, x: x, y: y}, nil
+}
+
+// binaryOperators are the binary operators by the precedence from the lowest.
+var binaryOperators = [][]string{
+	{"||"},
+	{"&&"},
+	{"==", "!="},
+	{"<", "<=", ">", ">="},
+	{"+", "-"},
+	{"*", "/", "%"},
+}
+
+func (p *parser) parseBinary(prec int) (expr, error) {
+	if prec == len(binaryOperators) {
+		return p.parseUnary()
+	}
+	x, err := p.parseBinary(prec + 1)
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		if t.kind != tokenOperator || !slices.Contains(binaryOperators[prec], t.text) {
+			return x, nil
+		}
+		p.next()
+		y, err := p.parseBinary(prec + 1)
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryExpr{op: t.text, x: x, y: y}
+	}
+}
+
+func (p *parser) parseUnary() (expr, error) {
+	t := p.peek()
+	if t.kind == tokenOperator {
+		switch t.text {
+		case "!", "-", "+":
+			p.next()
+			x, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			return &unaryExpr{op: t.text, x: x}, nil
+		case "++", "--":
+			p.next()
+			x, err := p.parseUnary()
+			if err != nil {
+				return nil, err
+			}
+			if !isAssignable(x) {
+				return nil, p.errorf(t, "cannot apply %s to the expression", t.text)
+			}
+			return &assignExpr{op: t.text[:1] + "=", lhs: x, rhs: &literal{v: int64(1)}}, nil
+		}
+	}
+	return p.parsePostfix()
+}
+
+func (p *parser) parsePostfix() (expr, error) {
+	x, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for {
+		t := p.peek()
+		switch {
+		case p.is("."):
+			p.next()
+			name := p.next()
+			if name.kind != tokenIdent {
+				return nil, p.errorf(name, "expected an attribute name but got %s", name)
+			}
+			x = &memberExpr{x: x, name: name.text}
+		case p.is("++"), p.is("--"):
+			if !isAssignable(x) {
+				return nil, p.errorf(t, "cannot apply %s to the expression", t.text)
+			}
+			p.next()
+			x = &assignExpr{op: t.text[:1] + "=", lhs: x, rhs: &literal{v: int64(1)}, postfix: true}
+		default:
+			return x, nil
+		}
+	}
+}
+
+func (p *parser) parsePrimary() (expr, error) {
+	t := p.next()
+	switch t.kind {
+	case tokenNumber:
+		if i, err := strconv.ParseInt(t.text, 10, 64); err == nil {
+			return &literal{v: i}, nil
+		}
+		f, err := strconv.ParseFloat(t.text, 64)
+		if err != nil {
+			return nil, p.errorf(t, "invalid number %s", t)
+		}
+		return &literal{v: f}, nil
+	case tokenString:
+		return &literal{v: t.text, pattern: strings.ContainsAny(t.text, "*?[")}, nil
+	case tokenIdent:
+		if p.is("(") {
+			p.next()
+			call := &callExpr{name: t.text}
+			for !p.is(")") {
+				arg, err := p.parseAssign()
+				if err != nil {
+					return nil, err
+				}
+				call.args = append(call.args, arg)
+				if !p.is(",") {
+					break
+				}
+				p.next()
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return call, nil
+		}
+		if t.text == "NULL" {
+			return &literal{}, nil
+		}
+		return &identExpr{name: t.text}, nil
+	case tokenOperator:
+		if t.text == "(" {
+			x, err := p.parseExpr()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(")"); err != nil {
+				return nil, err
+			}
+			return x, nil
+		}
+	}
+	return nil, p.errorf(t, "unexpected %s", t)
+}
+
+func isAssignable(x expr) bool {
+	switch x := x.(type) {
+	case *identExpr:
+		return !strings.HasPrefix(x.name, "$")
+	case *memberExpr:
+		return true
+	}
+	return false
+}
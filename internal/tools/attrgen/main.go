@@ -0,0 +1,413 @@
+// attrgen generates typed attribute getters of cgraph package from the attribute schema table,
+// setters of the attributes which do not have a hand-written one in attribute.go,
+// and TrySet* variants of all setters which validate the value before setting it.
+// It is invoked by go generate in the cgraph directory.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+)
+
+type valueType struct {
+	goType string
+	parse  string
+	// scheme reports whether parse takes the colorscheme attribute of the object as well.
+	scheme bool
+}
+
+var valueTypes = map[string]valueType{
+	"stringValue":      {goType: "string", parse: "parseString"},
+	"escStringValue":   {goType: "string", parse: "parseString"},
+	"lblStringValue":   {goType: "string", parse: "parseString"},
+	"colorValue":       {goType: "Color", parse: "parseColor", scheme: true},
+	"boolValue":        {goType: "bool", parse: "parseBool"},
+	"intValue":         {goType: "int", parse: "parseInt"},
+	"doubleValue":      {goType: "float64", parse: "parseDouble"},
+	"pointValue":       {goType: "Point", parse: "parsePoint"},
+	"pointListValue":   {goType: "[]Point", parse: "parsePointList"},
+	"rectValue":        {goType: "Rect", parse: "parseRect"},
+	"splineTypeValue":  {goType: "[]Spline", parse: "parseSplines"},
+	"colorListValue":   {goType: "ColorList", parse: "parseColorList", scheme: true},
+	"arrowTypeValue":   enumType("ArrowType"),
+	"clusterModeValue": enumType("ClusterMode"),
+	"dirTypeValue":     enumType("DirType"),
+	"imagePosValue":    enumType("ImagePos"),
+	"imageScaleValue":  enumType("ImageScale"),
+	"justValue":        enumType("JustType"),
+	"labelLocValue":    enumType("LabelLocation"),
+	"modeValue":        enumType("ModeType"),
+	"modelValue":       enumType("ModelType"),
+	"orderingValue":    enumType("OrderingType"),
+	"outputModeValue":  enumType("OutputMode"),
+	"packModeValue":    enumType("PackMode"),
+	"pageDirValue":     enumType("PageDir"),
+	"quadTypeValue":    enumType("QuadType"),
+	"rankDirValue":     enumType("RankDir"),
+	"ratioValue":       enumType("RatioType"),
+	"shapeValue":       enumType("Shape"),
+	"smoothTypeValue":  enumType("SmoothType"),
+	"startTypeValue":   enumType("StartType"),
+	"graphStyleValue":  enumListType("GraphStyle"),
+	"nodeStyleValue":   enumListType("NodeStyle"),
+	"edgeStyleValue":   enumListType("EdgeStyle"),
+	"tbBalanceValue":   {goType: "string", parse: "parseString"},
+}
+
+func enumType(name string) valueType {
+	return valueType{goType: name, parse: fmt.Sprintf("parseEnum[%s]", name)}
+}
+
+func enumListType(name string) valueType {
+	return valueType{goType: "[]" + name, parse: fmt.Sprintf("parseEnumList[%s]", name)}
+}
+
+type receiver struct {
+	name  string
+	typ   string
+	usage []string
+}
+
+var receivers = []receiver{
+	{name: "g", typ: "Graph", usage: []string{"graphUsage", "subgraphUsage", "clusterUsage"}},
+	{name: "n", typ: "Node", usage: []string{"nodeUsage"}},
+	{name: "e", typ: "Edge", usage: []string{"edgeUsage"}},
+}
+
+type schema struct {
+	name   string
+	attr   string
+	usage  map[string]bool
+	typ    string
+	def    string
+	getter string
+	setter string
+}
+
+func main() {
+	var (
+		attrFile   = flag.String("attr", "attribute.go", "path to the file defining attribute constants")
+		schemaFile = flag.String("schema", "attribute_schema.go", "path to the file defining attributeSchemas")
+		output     = flag.String("o", "attribute_getter.go", "output file path of the getters")
+		setterOut  = flag.String("setter", "attribute_setter.go", "output file path of the TrySet* variants")
+	)
+	flag.Parse()
+	attrNames, setters, err := loadAttributes(*attrFile)
+	if err != nil {
+		log.Fatal(err)
+	}
+	schemas, err := loadSchemas(*schemaFile, attrNames)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(schemas)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o600); err != nil {
+		log.Fatal(err)
+	}
+	setterSrc, err := generateSetters(schemas, setters, attrNames)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*setterOut, setterSrc, 0o600); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// setter is a Set* method of an attribute which is written as
+//
+//	func (n *Node) SetX(params) *Node {
+//		n.SafeSet(string(xAttr), value, def)
+//		return n
+//	}
+type setter struct {
+	recv   string
+	typ    string
+	name   string
+	params string
+	attr   string
+	value  string
+	def    string
+}
+
+// loadAttributes returns the attribute names keyed by the constant names and the setters defined in path.
+func loadAttributes(path string) (map[string]string, []*setter, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+	names := map[string]string{}
+	var setters []*setter
+	for _, decl := range f.Decls {
+		switch decl := decl.(type) {
+		case *ast.GenDecl:
+			if decl.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range decl.Specs {
+				spec := spec.(*ast.ValueSpec)
+				if typ, ok := spec.Type.(*ast.Ident); !ok || typ.Name != "attribute" {
+					continue
+				}
+				name, err := strconv.Unquote(spec.Values[0].(*ast.BasicLit).Value)
+				if err != nil {
+					return nil, nil, err
+				}
+				names[spec.Names[0].Name] = name
+			}
+		case *ast.FuncDecl:
+			if decl.Recv == nil || !strings.HasPrefix(decl.Name.Name, "Set") {
+				continue
+			}
+			s, err := newSetter(fset, decl)
+			if err != nil {
+				return nil, nil, err
+			}
+			setters = append(setters, s)
+		}
+	}
+	return names, setters, nil
+}
+
+func newSetter(fset *token.FileSet, decl *ast.FuncDecl) (*setter, error) {
+	invalid := fmt.Errorf("%s: %s is not a simple attribute setter", fset.Position(decl.Pos()), decl.Name.Name)
+	if len(decl.Body.List) != 2 {
+		return nil, invalid
+	}
+	stmt, ok := decl.Body.List[0].(*ast.ExprStmt)
+	if !ok {
+		return nil, invalid
+	}
+	call, ok := stmt.X.(*ast.CallExpr)
+	if !ok || len(call.Args) != 3 {
+		return nil, invalid
+	}
+	conv, ok := call.Args[0].(*ast.CallExpr)
+	if !ok || len(conv.Args) != 1 {
+		return nil, invalid
+	}
+	attr, ok := conv.Args[0].(*ast.Ident)
+	if !ok {
+		return nil, invalid
+	}
+	recv := decl.Recv.List[0]
+	return &setter{
+		recv:   recv.Names[0].Name,
+		typ:    recv.Type.(*ast.StarExpr).X.(*ast.Ident).Name,
+		name:   decl.Name.Name,
+		params: strings.TrimPrefix(source(fset, &ast.FuncType{Params: decl.Type.Params}), "func"),
+		attr:   attr.Name,
+		value:  source(fset, call.Args[1]),
+		def:    source(fset, call.Args[2]),
+	}, nil
+}
+
+func source(fset *token.FileSet, node any) string {
+	var b bytes.Buffer
+	_ = printer.Fprint(&b, fset, node)
+	return b.String()
+}
+
+func loadSchemas(path string, attrNames map[string]string) ([]*schema, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, path, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	var table *ast.CompositeLit
+	ast.Inspect(f, func(n ast.Node) bool {
+		spec, ok := n.(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || spec.Names[0].Name != "attributeSchemas" {
+			return true
+		}
+		table, _ = spec.Values[0].(*ast.CompositeLit)
+		return false
+	})
+	if table == nil {
+		return nil, fmt.Errorf("attributeSchemas is not found in %s", path)
+	}
+	var schemas []*schema
+	for _, elt := range table.Elts {
+		lit, ok := elt.(*ast.CompositeLit)
+		if !ok {
+			return nil, fmt.Errorf("%s: unexpected element", fset.Position(elt.Pos()))
+		}
+		s := &schema{usage: map[string]bool{}}
+		for _, kv := range lit.Elts {
+			kv := kv.(*ast.KeyValueExpr)
+			switch kv.Key.(*ast.Ident).Name {
+			case "name":
+				s.attr = kv.Value.(*ast.Ident).Name
+			case "usage":
+				collectUsage(kv.Value, s.usage)
+			case "typ":
+				s.typ = kv.Value.(*ast.Ident).Name
+			case "def":
+				s.def, err = strconv.Unquote(kv.Value.(*ast.BasicLit).Value)
+				if err != nil {
+					return nil, err
+				}
+			case "getter":
+				s.getter, err = strconv.Unquote(kv.Value.(*ast.BasicLit).Value)
+				if err != nil {
+					return nil, err
+				}
+			case "setter":
+				s.setter, err = strconv.Unquote(kv.Value.(*ast.BasicLit).Value)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+		if _, exists := valueTypes[s.typ]; !exists {
+			return nil, fmt.Errorf("%s: unknown value type %s", fset.Position(lit.Pos()), s.typ)
+		}
+		name, exists := attrNames[s.attr]
+		if !exists {
+			return nil, fmt.Errorf("%s: unknown attribute %s", fset.Position(lit.Pos()), s.attr)
+		}
+		s.name = name
+		schemas = append(schemas, s)
+	}
+	return schemas, nil
+}
+
+func collectUsage(expr ast.Expr, usage map[string]bool) {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		usage[v.Name] = true
+	case *ast.BinaryExpr:
+		collectUsage(v.X, usage)
+		collectUsage(v.Y, usage)
+	case *ast.ParenExpr:
+		collectUsage(v.X, usage)
+	}
+}
+
+func generate(schemas []*schema) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by attrgen. DO NOT EDIT.\n\n")
+	b.WriteString("package cgraph\n")
+	defined := map[string]bool{}
+	for _, s := range schemas {
+		if s.getter == "" {
+			continue
+		}
+		for _, r := range receivers {
+			if !r.has(s.usage) {
+				continue
+			}
+			key := r.typ + "." + s.getter
+			if defined[key] {
+				return nil, fmt.Errorf("%s is defined twice", key)
+			}
+			defined[key] = true
+			typ := valueTypes[s.typ]
+			fmt.Fprintf(&b, "\n// %s returns the parsed value of %s attribute.\n", s.getter, s.name)
+			if s.def == "" {
+				b.WriteString("// If it is not set or cannot be parsed, the zero value is returned with false.\n")
+			} else {
+				fmt.Fprintf(&b, "// If it is not set or cannot be parsed, the default value %s is returned with false.\n", strconv.Quote(s.def))
+			}
+			fmt.Fprintf(&b, "func (%s *%s) %s() (%s, bool) {\n", r.name, r.typ, s.getter, typ.goType)
+			parse := typ.parse
+			if typ.scheme {
+				parse = fmt.Sprintf("withColorScheme(%s.GetStr, %s)", r.name, typ.parse)
+			}
+			fmt.Fprintf(&b, "\treturn attributeValue(%s.GetStr, %s, %s, %s)\n", r.name, s.attr, strconv.Quote(s.def), parse)
+			b.WriteString("}\n")
+		}
+	}
+	return format.Source(b.Bytes())
+}
+
+func (r receiver) has(usage map[string]bool) bool {
+	for _, u := range r.usage {
+		if usage[u] {
+			return true
+		}
+	}
+	return false
+}
+
+// setterValues are the expressions converting the argument of the generated setter to the attribute value.
+var setterValues = map[string]string{
+	"string":  "v",
+	"bool":    "toBoolString(v)",
+	"int":     "fmt.Sprint(v)",
+	"float64": "fmt.Sprint(v)",
+}
+
+func generateSetters(schemas []*schema, setters []*setter, attrNames map[string]string) ([]byte, error) {
+	var body bytes.Buffer
+	defined := map[string]bool{}
+	for _, s := range setters {
+		defined[s.typ+"."+s.name] = true
+	}
+	for _, s := range schemas {
+		if s.setter == "" {
+			continue
+		}
+		typ := valueTypes[s.typ]
+		value, exists := setterValues[typ.goType]
+		if !exists {
+			return nil, fmt.Errorf("setter of %s type cannot be generated", typ.goType)
+		}
+		for _, r := range receivers {
+			if !r.has(s.usage) {
+				continue
+			}
+			key := r.typ + "." + s.setter
+			if defined[key] {
+				return nil, fmt.Errorf("%s is defined twice", key)
+			}
+			defined[key] = true
+			fmt.Fprintf(&body, "\n// %s sets %s attribute.\n", s.setter, s.name)
+			fmt.Fprintf(&body, "// https://graphviz.gitlab.io/_pages/doc/info/attrs.html#a:%s\n", s.name)
+			fmt.Fprintf(&body, "func (%s *%s) %s(v %s) *%s {\n", r.name, r.typ, s.setter, typ.goType, r.typ)
+			fmt.Fprintf(&body, "\t%s.SafeSet(string(%s), %s, %s)\n", r.name, s.attr, value, strconv.Quote(s.def))
+			fmt.Fprintf(&body, "\treturn %s\n}\n", r.name)
+			setters = append(setters, &setter{
+				recv:   r.name,
+				typ:    r.typ,
+				name:   s.setter,
+				params: fmt.Sprintf("(v %s)", typ.goType),
+				attr:   s.attr,
+				value:  value,
+				def:    strconv.Quote(s.def),
+			})
+		}
+	}
+	for _, s := range setters {
+		name, exists := attrNames[s.attr]
+		if !exists {
+			return nil, fmt.Errorf("%s uses unknown attribute %s", s.name, s.attr)
+		}
+		fmt.Fprintf(&body, "\n// Try%s is like %s but validates the value against the type of %s attribute.\n", s.name, s.name, name)
+		body.WriteString("// If the value is invalid, it is not set and an *AttributeError is returned.\n")
+		fmt.Fprintf(&body, "func (%s *%s) Try%s%s error {\n", s.recv, s.typ, s.name, s.params)
+		fmt.Fprintf(&body, "\treturn %s.trySet(%s, %s, %s)\n", s.recv, s.attr, s.value, s.def)
+		body.WriteString("}\n")
+	}
+	var b bytes.Buffer
+	b.WriteString("// Code generated by attrgen. DO NOT EDIT.\n\n")
+	b.WriteString("package cgraph\n")
+	if strings.Contains(body.String(), "fmt.") {
+		b.WriteString("\nimport \"fmt\"\n")
+	}
+	b.Write(body.Bytes())
+	return format.Source(b.Bytes())
+}
@@ -85,3 +85,91 @@ func FileSystem() fs.FS {
 	defer fsMu.Unlock()
 	return mod.fs
 }
+
+// AnonymousEdge creates a new edge without a name like agedge(g, tail, head, NULL, 1).
+// Unlike Edge with an empty name, it creates a parallel edge even if the nodes are already connected.
+func (v *Graph) AnonymousEdge(ctx context.Context, tail *Node, head *Node) (*Edge, error) {
+	p, err := mod.callWithRet(ctx, "Graph_edge", v.getPtr(), tail.getPtr(), head.getPtr(), 0, 1)
+	if err != nil {
+		return nil, err
+	}
+	return newEdge(p), nil
+}
+
+// IsHTMLStr reports whether the value of the attribute is an HTML string created by agstrdup_html like aghtmlstr(agget(obj, name)).
+// Unlike HtmlStr, the string is not copied, so the flag of the stored string is returned.
+func IsHTMLStr(ctx context.Context, obj any, name string) (bool, error) {
+	arg0, err := mod.toAnyWasmValue(ctx, obj)
+	if err != nil {
+		return false, err
+	}
+	arg1, err := mod.toStringWasmValue(ctx, name)
+	if err != nil {
+		return false, err
+	}
+	p, err := mod.callWithRet(ctx, "getStr", arg0, arg1)
+	if err != nil {
+		return false, err
+	}
+	return isHTMLString(ctx, p)
+}
+
+// IsHTMLDefval reports whether the default value of the attribute is an HTML string like aghtmlstr(sym->defval).
+func (v *Sym) IsHTMLDefval(ctx context.Context) (bool, error) {
+	p, err := mod.getField(ctx, "Sym_defval", v.getPtr())
+	if err != nil {
+		return false, err
+	}
+	return isHTMLString(ctx, p)
+}
+
+// isHTMLString calls aghtmlstr with the data of the string returned by the bridge, which points to the string of cgraph.
+func isHTMLString(ctx context.Context, p uint64) (bool, error) {
+	if p == 0 {
+		return false, nil
+	}
+	s, err := mod.readU32(p)
+	if err != nil {
+		return false, err
+	}
+	return IsHTMLRef(ctx, s)
+}
+
+// StrdupHTMLRef creates a reference to the HTML string like agstrdup_html(g, s).
+// Unlike StrdupHTML, the string is not copied, so the reference can be freed by StrFreeRef.
+func (v *Graph) StrdupHTMLRef(ctx context.Context, s string) (uint64, error) {
+	arg0, err := mod.toStringWasmValue(ctx, s)
+	if err != nil {
+		return 0, err
+	}
+	p, err := mod.callWithRet(ctx, "Graph_strdupHTML", v.getPtr(), arg0)
+	if err != nil {
+		return 0, err
+	}
+	if p == 0 {
+		return 0, nil
+	}
+	return mod.readU32(p)
+}
+
+// StrFreeRef frees the reference created by StrdupHTMLRef like agstrfree(g, ref).
+func (v *Graph) StrFreeRef(ctx context.Context, ref uint64) (int, error) {
+	p, err := mod.callWithRet(ctx, "Graph_strFree", v.getPtr(), ref)
+	if err != nil {
+		return 0, err
+	}
+	return mod.toInt(p), nil
+}
+
+// IsHTMLRef reports whether the string referenced by the pointer is an HTML string like aghtmlstr(ref).
+func IsHTMLRef(ctx context.Context, ref uint64) (bool, error) {
+	if ref == 0 {
+		return false, nil
+	}
+	ret, err := mod.callWithRet(ctx, "htmlStr", ref)
+	if err != nil {
+		return false, err
+	}
+	// only the low byte is written for bool.
+	return ret&0xff == 1, nil
+}
@@ -0,0 +1,75 @@
+package graphviz
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/goccy/go-graphviz/cgraph"
+)
+
+// ArrayPackMode returns the pack mode "array_flagsN" which places the components in an array.
+// flags are c ( column-major ), t, b, l, r ( alignment ) and u ( ordered by the sortv attribute ).
+// n is the number of columns, or rows if column-major. It is ignored if it is 0.
+func ArrayPackMode(flags string, n int) PackMode {
+	mode := string(ArrayPack)
+	if flags != "" {
+		mode += "_" + flags
+	}
+	if n > 0 {
+		if flags == "" {
+			mode += "_"
+		}
+		mode += strconv.Itoa(n)
+	}
+	return PackMode(mode)
+}
+
+var packModePattern = regexp.MustCompile(`^(node|clust|graph|array(_[ctblru]*[0-9]*)?)$`)
+
+// PackOptions are the options of Pack.
+type PackOptions struct {
+	// Mode is the pack mode: NodePack, ClusterPack, GraphPack, ArrayPack or ArrayPackMode. GraphPack is used if it is empty.
+	Mode PackMode
+	// Margin is the space around the components in points. The default of Graphviz ( 8 points ) is used if it is 0.
+	Margin int
+}
+
+// Pack combines the graphs into one graph whose components are packed by the layout engine when it is rendered.
+// The graphs are copied by cgraph.DisjointUnion, so the nodes and subgraphs of the same names are renamed,
+// and the pack and packmode attributes are set. The returned graph can be rendered in any format
+// by the layout engines which support packing: circo, dot, fdp, neato, osage, sfdp and twopi.
+//
+// Unlike the gvpack tool, the existing layouts of the graphs are not kept because the combined graph is laid out again,
+// and the units of packing are the connected components of the combined graph rather than the input graphs,
+// so a graph which has several connected components is not kept together.
+func (g *Graphviz) Pack(ctx context.Context, graphs []*Graph, opts PackOptions) (*Graph, error) {
+	mode := opts.Mode
+	if mode == "" {
+		mode = GraphPack
+	}
+	if !packModePattern.MatchString(string(mode)) {
+		return nil, fmt.Errorf("invalid pack mode %q", mode)
+	}
+	if opts.Margin < 0 {
+		return nil, fmt.Errorf("invalid pack margin %d", opts.Margin)
+	}
+	packed, err := cgraph.DisjointUnion(graphs...)
+	if err != nil {
+		return nil, err
+	}
+	pack := "true"
+	if opts.Margin > 0 {
+		pack = strconv.Itoa(opts.Margin)
+	}
+	if err := packed.SafeSet("pack", pack, ""); err != nil {
+		_ = packed.Close()
+		return nil, err
+	}
+	if err := packed.SafeSet("packmode", string(mode), ""); err != nil {
+		_ = packed.Close()
+		return nil, err
+	}
+	return packed, nil
+}